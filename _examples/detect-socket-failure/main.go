@@ -25,7 +25,7 @@ func main() {
 	}
 
 	// Enable Page events for this tab.
-	if enableResult := <-tab.Page().Enable(); nil != enableResult.Err {
+	if enableResult := <-tab.Page().Enable(nil); nil != enableResult.Err {
 		fmt.Printf("%+v\n", enableResult.Err)
 	}
 
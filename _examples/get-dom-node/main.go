@@ -31,12 +31,12 @@ func main() {
 	}
 
 	// Enable Page events for this tab.
-	if enableResult := <-tab.Page().Enable(); nil != enableResult.Err {
+	if enableResult := <-tab.Page().Enable(nil); nil != enableResult.Err {
 		panic(enableResult.Err)
 	}
 
 	// Enable the DOM agent for this tab.
-	if enableResult := <-tab.DOM().Enable(); nil != enableResult.Err {
+	if enableResult := <-tab.DOM().Enable(nil); nil != enableResult.Err {
 		panic(enableResult.Err)
 	}
 
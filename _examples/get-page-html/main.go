@@ -41,7 +41,7 @@ func main() {
 	})
 
 	// Enable the DOM agent for this tab.
-	if enableResult := <-tab.DOM().Enable(); nil != enableResult.Err {
+	if enableResult := <-tab.DOM().Enable(nil); nil != enableResult.Err {
 		panic(enableResult.Err)
 	}
 
@@ -74,7 +74,7 @@ func main() {
 		panic(err)
 	}
 
-	if enableResult := <-t.Page().Enable(); enableResult.Err != nil {
+	if enableResult := <-t.Page().Enable(nil); enableResult.Err != nil {
 		panic(enableResult.Err)
 	}
 
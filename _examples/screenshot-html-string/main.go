@@ -51,7 +51,7 @@ func main() {
 	}
 
 	// Enable Page events for this tab.
-	if enableResult := <-tab.Page().Enable(); nil != enableResult.Err {
+	if enableResult := <-tab.Page().Enable(nil); nil != enableResult.Err {
 		panic(enableResult.Err)
 	}
 
@@ -78,7 +78,7 @@ func main() {
 			Width:  300,
 			Height: 300,
 			ScreenOrientation: &emulation.ScreenOrientation{
-				Type:  emulation.OrientationType.PortraitPrimary,
+				Type:  emulation.Type.PortraitPrimary,
 				Angle: 90,
 			},
 		},
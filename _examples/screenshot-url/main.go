@@ -32,7 +32,7 @@ func main() {
 	}
 
 	// Enable Page events for this tab.
-	if enableResult := <-tab.Page().Enable(); nil != enableResult.Err {
+	if enableResult := <-tab.Page().Enable(nil); nil != enableResult.Err {
 		panic(enableResult.Err)
 	}
 
@@ -55,7 +55,7 @@ func main() {
 					Width:  1440,
 					Height: 1440,
 					ScreenOrientation: &emulation.ScreenOrientation{
-						Type:  emulation.OrientationType.PortraitPrimary,
+						Type:  emulation.Type.PortraitPrimary,
 						Angle: 90,
 					},
 				},
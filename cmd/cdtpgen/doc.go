@@ -0,0 +1,101 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+/*
+lineWidth is the column generated comments are wrapped at.
+*/
+const lineWidth = 80
+
+/*
+wrap collapses the whitespace in text and wraps it into lines no wider than
+width columns once the prefix is added. Tabs in the prefix count as 4 columns.
+*/
+func wrap(text, prefix string) []string {
+	prefixWidth := len(strings.Replace(prefix, "\t", "    ", -1))
+	lines := []string{}
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if "" != line && prefixWidth+len(line)+1+len(word) > lineWidth {
+			lines = append(lines, prefix+line)
+			line = ""
+		}
+		if "" == line {
+			line = word
+		} else {
+			line += " " + word
+		}
+	}
+	if "" != line {
+		lines = append(lines, prefix+line)
+	}
+	return lines
+}
+
+/*
+sentence trims text and terminates it with a period.
+*/
+func sentence(text string) string {
+	text = strings.TrimSpace(text)
+	if "" == text {
+		return text
+	}
+	if !strings.HasSuffix(text, ".") {
+		text += "."
+	}
+	return text
+}
+
+/*
+lowerFirst lower-cases the first letter of text unless it starts an acronym.
+*/
+func lowerFirst(text string) string {
+	runes := []rune(text)
+	if len(runes) < 2 || !unicode.IsUpper(runes[0]) || unicode.IsUpper(runes[1]) {
+		return text
+	}
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+/*
+annotate appends the experimental and deprecated markers to a description.
+*/
+func annotate(text string, experimental, deprecated bool) string {
+	text = sentence(text)
+	if experimental {
+		text += " EXPERIMENTAL."
+	}
+	if deprecated {
+		text += " DEPRECATED."
+	}
+	return strings.TrimSpace(text)
+}
+
+/*
+blockComment renders a declaration doc comment in the repository style: the
+wrapped description followed by an optional reference URL.
+*/
+func blockComment(text, url string) string {
+	lines := []string{"/*"}
+	lines = append(lines, wrap(text, "")...)
+	if "" != url {
+		lines = append(lines, "", url)
+	}
+	lines = append(lines, "*/")
+	return strings.Join(lines, "\n") + "\n"
+}
+
+/*
+fieldComment renders a struct field comment.
+*/
+func fieldComment(text string) string {
+	lines := wrap(text, "\t// ")
+	if 0 == len(lines) {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
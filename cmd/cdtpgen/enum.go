@@ -0,0 +1,235 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+/*
+enumMember describes a single enum value.
+*/
+type enumMember struct {
+	// Field is the exported field name used to access the value.
+	Field string
+
+	// Const is the name of the unexported constant holding the value.
+	Const string
+
+	// Value is the protocol string value.
+	Value string
+}
+
+/*
+members returns the members of an enum. Values that map to the same Go name are
+disambiguated with a numeric suffix.
+*/
+func (enum *enumDef) members() []enumMember {
+	members := []enumMember{}
+	seen := map[string]bool{}
+	for _, value := range enum.Values {
+		name := GoName(value)
+		if seen[name] {
+			for a := 2; ; a++ {
+				if !seen[fmt.Sprintf("%s%d", name, a)] {
+					name = fmt.Sprintf("%s%d", name, a)
+					break
+				}
+			}
+		}
+		seen[name] = true
+		members = append(members, enumMember{
+			Field: name,
+			Const: lowerName(enum.Name) + name,
+			Value: value,
+		})
+	}
+	return members
+}
+
+/*
+Expected returns the quoted test failure message for the member's JSON value.
+*/
+func (member enumMember) Expected() string {
+	return strconv.Quote(fmt.Sprintf("Expected '%q', got '%%s'", member.Value))
+}
+
+/*
+doc returns the enum type doc comment, listing the allowed values.
+*/
+func (enum *enumDef) doc() string {
+	description := strings.TrimSuffix(sentence(enum.Description), ".")
+	if "" == description {
+		description = "an enumerated value"
+	}
+	lines := []string{"/*"}
+	lines = append(lines, wrap(fmt.Sprintf("%sEnum represents %s. Allowed values:", enum.Name, lowerFirst(description)), "")...)
+
+	members := enum.members()
+	width := 0
+	for _, member := range members {
+		if len(member.Field) > width {
+			width = len(member.Field)
+		}
+	}
+	for _, member := range members {
+		lines = append(lines, fmt.Sprintf("\t- %s.%-*s %q", enum.Name, width, member.Field, member.Value))
+	}
+	if "" != enum.URL {
+		lines = append(lines, "", enum.URL)
+	}
+	lines = append(lines, "*/")
+	return strings.Join(lines, "\n")
+}
+
+/*
+writeEnum generates the file and test for an enum.
+*/
+func (gen *Generator) writeEnum(pkg *pkgGen, enum *enumDef) {
+	data := map[string]interface{}{
+		"Package": pkg.name,
+		"Name":    enum.Name,
+		"Lower":   lowerName(enum.Name),
+		"Doc":     enum.doc(),
+		"Members": enum.members(),
+	}
+	base := PackagePath(pkg.domain.Domain) + "/enum." + snakeName(enum.Name)
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(header)
+	if err := enumTemplate.Execute(buf, data); nil != err {
+		gen.errorf("%s: %s", base, err)
+		return
+	}
+	gen.addFile(base+".go", buf.Bytes())
+
+	buf = &bytes.Buffer{}
+	buf.WriteString(header)
+	if err := enumTestTemplate.Execute(buf, data); nil != err {
+		gen.errorf("%s: %s", base, err)
+		return
+	}
+	gen.addFile(base+"_test.go", buf.Bytes())
+}
+
+var enumTemplate = template.Must(template.New("enum").Parse(`package {{.Package}}
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type {{.Lower}}Enum struct {
+{{- range .Members}}
+	{{.Field}} {{$.Name}}Enum
+{{- end}}
+}
+
+/*
+{{.Name}} provides named access to the {{.Name}}Enum values.
+*/
+var {{.Name}} = {{.Lower}}Enum{
+{{- range .Members}}
+	{{.Field}}: {{.Const}},
+{{- end}}
+}
+
+{{.Doc}}
+type {{.Name}}Enum int
+
+/*
+String implements Stringer
+*/
+func (enum {{.Name}}Enum) String() string {
+	return _{{.Lower}}Enums[enum]
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum {{.Name}}Enum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler
+*/
+func (enum *{{.Name}}Enum) UnmarshalJSON(bytes []byte) error {
+	var err error
+	var val string
+
+	err = json.Unmarshal(bytes, &val)
+	if nil != err {
+		return err
+	}
+
+	for k, v := range _{{.Lower}}Enums {
+		if v == val {
+			*enum = k
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not a valid type value", bytes)
+}
+
+const (
+{{- range $a, $member := .Members}}
+	// {{$member.Const}} represents the {{printf "%q" $member.Value}} value.
+	{{- if eq $a 0}}
+	{{$member.Const}} {{$.Name}}Enum = iota + 1
+	{{- else}}
+	{{$member.Const}}
+	{{- end}}
+{{- end}}
+)
+
+var _{{.Lower}}Enums = map[{{.Name}}Enum]string{
+{{- range .Members}}
+	{{.Const}}: {{printf "%q" .Value}},
+{{- end}}
+}
+`))
+
+var enumTestTemplate = template.Must(template.New("enumTest").Parse(`package {{.Package}}
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnum{{.Name}}(t *testing.T) {
+	var enum {{.Name}}Enum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(` + "`\"\"`" + `), &enum)
+	if nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if ` + "`\"\"`" + ` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+{{range .Members}}
+	enum = {{$.Name}}.{{.Field}}
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if ` + "`{{printf \"%q\" .Value}}`" + ` != string(result) {
+		t.Errorf({{.Expected}}, result)
+	}
+	json.Unmarshal([]byte(` + "`{{printf \"%q\" .Value}}`" + `), &enum)
+	if {{$.Name}}.{{.Field}} != enum {
+		t.Errorf("Expected %d, got %d", {{$.Name}}.{{.Field}}, enum)
+	}
+{{end -}}
+}
+`))
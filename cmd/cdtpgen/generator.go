@@ -0,0 +1,454 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+/*
+header marks every generated file.
+*/
+const header = "// Code generated by cdtpgen. DO NOT EDIT.\n\n"
+
+/*
+Generator generates domain packages and socket protocol wrappers from a
+protocol definition.
+*/
+type Generator struct {
+	// Protocol is the protocol definition to generate code from.
+	Protocol *Protocol
+
+	// ImportPath is the import path of the protocol version root, e.g.
+	// 'github.com/mkenney/go-chrome/tot'.
+	ImportPath string
+
+	// Version is the protocol version used in documentation URLs, e.g. 'tot'.
+	Version string
+
+	// SocketDir is the directory the protocol wrappers are written to,
+	// relative to the version root. Defaults to 'socket'.
+	SocketDir string
+
+	// Protocoller enables generating the Protocoller interface, its socket
+	// implementation and the Tab accessors for the generated domains.
+	Protocoller bool
+
+	// Surface enables generating the socket Surface and StableSurface
+	// variables listing the methods and events of the generated domains.
+	Surface bool
+
+	errs  []string
+	files map[string][]byte
+	graph *importGraph
+}
+
+/*
+field describes a generated struct field.
+*/
+type field struct {
+	Name     string
+	JSON     string
+	Type     goType
+	Optional bool
+}
+
+/*
+commandInfo describes a generated command.
+*/
+type commandInfo struct {
+	Name        string
+	Method      string
+	Description string
+	URL         string
+	HasParams   bool
+	Params      []field
+	Returns     []field
+}
+
+/*
+eventInfo describes a generated event.
+*/
+type eventInfo struct {
+	Name        string
+	Method      string
+	Description string
+	URL         string
+	Params      []field
+}
+
+/*
+Generate generates the specified domains, or all domains if none are specified,
+and returns the generated file contents keyed by path relative to the version
+root.
+*/
+func (gen *Generator) Generate(domains ...string) (map[string][]byte, error) {
+	gen.errs = []string{}
+	gen.files = map[string][]byte{}
+	gen.graph = newImportGraph(gen.Protocol)
+	if "" == gen.SocketDir {
+		gen.SocketDir = "socket"
+	}
+
+	if 0 == len(domains) {
+		for _, domain := range gen.Protocol.Domains {
+			if !domain.Omitted {
+				domains = append(domains, domain.Domain)
+			}
+		}
+	}
+	generated := []*Domain{}
+	for _, name := range domains {
+		domain := gen.Protocol.Domain(name)
+		if nil == domain {
+			gen.errorf("unknown domain '%s'", name)
+			continue
+		}
+		gen.generateDomain(domain)
+		generated = append(generated, domain)
+	}
+	if gen.Protocoller {
+		gen.writeProtocoller(generated)
+	}
+	if gen.Surface {
+		gen.writeSurface(generated)
+	}
+
+	if len(gen.errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(gen.errs, "\n"))
+	}
+	return gen.files, nil
+}
+
+/*
+GenerateEnum generates the enum file and test for a single named enum type in
+an existing, hand-written domain package.
+*/
+func (gen *Generator) GenerateEnum(ref string) (map[string][]byte, error) {
+	gen.errs = []string{}
+	gen.files = map[string][]byte{}
+	gen.graph = newImportGraph(gen.Protocol)
+
+	domainName, id := splitRef("", ref)
+	domain := gen.Protocol.Domain(domainName)
+	if nil == domain || nil == domain.Type(id) || !isEnum(domain.Type(id)) {
+		return nil, fmt.Errorf("unknown enum type '%s'", ref)
+	}
+	pkg := newPkgGen(gen, domain)
+	gen.writeEnum(pkg, pkg.namedEnum(domain.Type(id)))
+
+	if len(gen.errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(gen.errs, "\n"))
+	}
+	return gen.files, nil
+}
+
+/*
+Write writes generated files below the specified directory.
+*/
+func Write(dir string, files map[string][]byte) error {
+	paths := []string{}
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		file := filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(file), 0755); nil != err {
+			return err
+		}
+		if err := ioutil.WriteFile(file, files[path], 0644); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+errorf records a generation error.
+*/
+func (gen *Generator) errorf(format string, args ...interface{}) {
+	gen.errs = append(gen.errs, fmt.Sprintf(format, args...))
+}
+
+/*
+url returns the protocol documentation URL for a domain and optional anchor.
+*/
+func (gen *Generator) url(domain, anchor string) string {
+	url := fmt.Sprintf("https://chromedevtools.github.io/devtools-protocol/%s/%s/", gen.Version, domain)
+	if "" != anchor {
+		url += "#" + anchor
+	}
+	return url
+}
+
+/*
+addFile formats and stores a generated file.
+*/
+func (gen *Generator) addFile(path string, src []byte) {
+	formatted, err := format.Source(src)
+	if nil != err {
+		gen.errorf("%s: %s", path, err)
+		return
+	}
+	gen.files[path] = formatted
+}
+
+/*
+generateDomain generates the package and protocol wrappers for a domain.
+*/
+func (gen *Generator) generateDomain(domain *Domain) {
+	pkg := newPkgGen(gen, domain)
+	dir := PackagePath(domain.Domain) + "/"
+
+	types := pkg.renderTypes()
+	commandBody, commands := pkg.renderCommands()
+	eventBody, events := pkg.renderEvents()
+	types += pkg.renderDups()
+	aliases := pkg.aliases()
+
+	doc := blockComment(
+		fmt.Sprintf("Package %s provides type definitions for use with the Chrome %s protocol", pkg.name, domain.Domain),
+		gen.url(domain.Domain, ""),
+	)
+	gen.addFile(dir+"cdtp.go", pkg.source(doc, types, aliases))
+	if len(commands) > 0 {
+		gen.addFile(dir+"command.go", pkg.source("", commandBody, aliases))
+	}
+	if len(events) > 0 {
+		gen.addFile(dir+"event.go", pkg.source("", eventBody, aliases))
+	}
+	for _, enum := range pkg.enums {
+		gen.writeEnum(pkg, enum)
+	}
+	gen.writeSocket(pkg, commands, events)
+}
+
+/*
+aliases returns the import alias of each domain imported by the package. Package
+names that clash with the current package or another import are replaced with
+the camel-cased domain name.
+*/
+func (pkg *pkgGen) aliases() map[string]string {
+	count := map[string]int{pkg.name: 1}
+	for domain := range pkg.imports {
+		count[PackageName(domain)]++
+	}
+	aliases := map[string]string{}
+	for domain := range pkg.imports {
+		aliases[domain] = PackageName(domain)
+		if count[PackageName(domain)] > 1 {
+			aliases[domain] = lowerName(GoName(domain))
+		}
+	}
+	return aliases
+}
+
+/*
+qualifierPattern matches the placeholders used to qualify identifiers from
+other domain packages.
+*/
+var qualifierPattern = regexp.MustCompile("\x00([A-Za-z]+)\x00\\.")
+
+/*
+source assembles a package source file, adding the imports required by the
+body.
+*/
+func (pkg *pkgGen) source(doc, body string, aliases map[string]string) []byte {
+	used := map[string]bool{}
+	for _, match := range qualifierPattern.FindAllStringSubmatch(body, -1) {
+		used[match[1]] = true
+	}
+	body = qualifierPattern.ReplaceAllStringFunc(body, func(match string) string {
+		return aliases[strings.Trim(match, "\x00.")] + "."
+	})
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(header)
+	buf.WriteString(doc)
+	fmt.Fprintf(buf, "package %s\n\n", pkg.name)
+	if len(used) > 0 {
+		imports := []string{}
+		for domain := range used {
+			path := pkg.gen.ImportPath + "/" + PackagePath(domain)
+			if aliases[domain] == PackageName(domain) {
+				imports = append(imports, fmt.Sprintf("\t%q", path))
+			} else {
+				imports = append(imports, fmt.Sprintf("\t%s %q", aliases[domain], path))
+			}
+		}
+		sort.Slice(imports, func(a, b int) bool {
+			return strings.Trim(imports[a], "\t\"") < strings.Trim(imports[b], "\t\"")
+		})
+		fmt.Fprintf(buf, "import (\n%s\n)\n\n", strings.Join(imports, "\n"))
+	}
+	buf.WriteString(body)
+	return buf.Bytes()
+}
+
+/*
+typeDoc returns the doc comment text for a named type.
+*/
+func typeDoc(name, domain string, typ *Type) string {
+	description := strings.TrimSpace(typ.Description)
+	switch {
+	case "" == description:
+		description = fmt.Sprintf("%s is the %s.%s type.", name, domain, typ.ID)
+	case strings.HasPrefix(description, "This "):
+		description = name + " " + description[len("This "):]
+	default:
+		description = name + " represents " + lowerFirst(description)
+	}
+	return annotate(description, typ.Experimental, typ.Deprecated)
+}
+
+/*
+renderTypes renders the named types declared by the domain.
+*/
+func (pkg *pkgGen) renderTypes() string {
+	buf := &bytes.Buffer{}
+	for _, typ := range pkg.domain.Types {
+		if isEnum(typ) {
+			pkg.namedEnum(typ)
+			continue
+		}
+		buf.WriteString(pkg.renderType(pkg.domain.Domain, typ, GoName(typ.ID), ""))
+	}
+	return buf.String()
+}
+
+/*
+renderDups renders the types duplicated from other domains. Duplicated types may
+require further duplicates so the list is consumed until it is exhausted.
+*/
+func (pkg *pkgGen) renderDups() string {
+	buf := &bytes.Buffer{}
+	for a := 0; a < len(pkg.dups); a++ {
+		dup := pkg.dups[a]
+		note := fmt.Sprintf("This is a duplicate of %s.%s to avoid an invalid import cycle.", dup.Domain, dup.Type.ID)
+		buf.WriteString(pkg.renderType(dup.Domain, dup.Type, dup.Name, note))
+	}
+	return buf.String()
+}
+
+/*
+renderType renders a named type declaration. Types from other domains are
+resolved relative to the domain they are declared in.
+*/
+func (pkg *pkgGen) renderType(domain string, typ *Type, name, note string) string {
+	url := pkg.gen.url(domain, "type-"+typ.ID)
+	doc := typeDoc(name, domain, typ)
+	if "" != note {
+		doc += " " + note
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("\n" + blockComment(doc, url))
+	if isStruct(typ) {
+		body, _ := pkg.renderFields(domain, typ.ID, url, typ.Properties)
+		fmt.Fprintf(buf, "type %s struct {\n%s}\n", name, strings.TrimSuffix(body, "\n"))
+	} else {
+		fmt.Fprintf(buf, "type %s %s\n", name, pkg.underlying(domain, typ).Expr)
+	}
+	return buf.String()
+}
+
+/*
+renderFields renders struct fields for a list of properties.
+*/
+func (pkg *pkgGen) renderFields(domain, owner, url string, props []*Property) (string, []field) {
+	buf := &bytes.Buffer{}
+	fields := []field{}
+	for _, prop := range props {
+		f := field{
+			Name:     GoName(prop.Name),
+			JSON:     prop.Name,
+			Type:     pkg.propType(domain, owner, prop, url),
+			Optional: prop.Optional,
+		}
+		fields = append(fields, f)
+
+		description := prop.Description
+		if prop.Optional {
+			description = "Optional. " + description
+		}
+		tag := f.JSON
+		if prop.Optional {
+			tag += ",omitempty"
+		}
+		buf.WriteString(fieldComment(annotate(description, prop.Experimental, prop.Deprecated)))
+		fmt.Fprintf(buf, "\t%s %s `json:\"%s\"`\n\n", f.Name, f.Type.Expr, tag)
+	}
+	return buf.String(), fields
+}
+
+/*
+renderCommands renders the parameter and result structs for each command.
+*/
+func (pkg *pkgGen) renderCommands() (string, []*commandInfo) {
+	buf := &bytes.Buffer{}
+	commands := []*commandInfo{}
+	for a, command := range pkg.domain.Commands {
+		info := &commandInfo{
+			Name:        GoName(command.Name),
+			Method:      pkg.domain.Domain + "." + command.Name,
+			Description: annotate(command.Description, command.Experimental, command.Deprecated),
+			URL:         pkg.gen.url(pkg.domain.Domain, "method-"+command.Name),
+			HasParams:   len(command.Parameters) > 0,
+		}
+		commands = append(commands, info)
+
+		if a > 0 {
+			buf.WriteString("\n")
+		}
+		if info.HasParams {
+			var body string
+			body, info.Params = pkg.renderFields(pkg.domain.Domain, command.Name, info.URL, command.Parameters)
+			buf.WriteString(blockComment(fmt.Sprintf("%sParams represents %s parameters.", info.Name, info.Method), info.URL))
+			fmt.Fprintf(buf, "type %sParams struct {\n%s}\n\n", info.Name, strings.TrimSuffix(body, "\n"))
+		}
+
+		var body string
+		body, info.Returns = pkg.renderFields(pkg.domain.Domain, command.Name, info.URL, command.Returns)
+		buf.WriteString(blockComment(fmt.Sprintf("%sResult represents the result of calls to %s.", info.Name, info.Method), info.URL))
+		fmt.Fprintf(buf, "type %sResult struct {\n%s", info.Name, body)
+		buf.WriteString("\t// Error information related to executing this method\n")
+		buf.WriteString("\tErr error `json:\"-\"`\n}\n")
+	}
+	return buf.String(), commands
+}
+
+/*
+renderEvents renders the data structs for each event.
+*/
+func (pkg *pkgGen) renderEvents() (string, []*eventInfo) {
+	buf := &bytes.Buffer{}
+	events := []*eventInfo{}
+	for a, event := range pkg.domain.Events {
+		info := &eventInfo{
+			Name:        GoName(event.Name),
+			Method:      pkg.domain.Domain + "." + event.Name,
+			Description: annotate(event.Description, event.Experimental, event.Deprecated),
+			URL:         pkg.gen.url(pkg.domain.Domain, "event-"+event.Name),
+		}
+		events = append(events, info)
+
+		if a > 0 {
+			buf.WriteString("\n")
+		}
+		var body string
+		body, info.Params = pkg.renderFields(pkg.domain.Domain, event.Name, info.URL, event.Parameters)
+		buf.WriteString(blockComment(fmt.Sprintf("%sEvent represents %s event data.", info.Name, info.Method), info.URL))
+		fmt.Fprintf(buf, "type %sEvent struct {\n%s", info.Name, body)
+		buf.WriteString("\t// Error information related to this event\n")
+		buf.WriteString("\tErr error `json:\"-\"`\n}\n")
+	}
+	return buf.String(), events
+}
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func generate(t *testing.T, domains ...string) map[string][]byte {
+	protocol, err := LoadProtocol("testdata/protocol.json")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+	gen := &Generator{
+		Protocol:   protocol,
+		ImportPath: "example.com/protocol",
+		Version:    "1-3",
+	}
+	files, err := gen.Generate(domains...)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+	return files
+}
+
+func expectContains(t *testing.T, files map[string][]byte, path string, expected ...string) {
+	src, ok := files[path]
+	if !ok {
+		t.Errorf("Expected file '%s' to be generated", path)
+		return
+	}
+	for _, str := range expected {
+		if !strings.Contains(string(src), str) {
+			t.Errorf("Expected '%s' to contain '%s', got:\n%s", path, str, src)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	files := generate(t)
+
+	for _, path := range []string{
+		"alpha/cdtp.go",
+		"alpha/command.go",
+		"alpha/event.go",
+		"alpha/enum.mode.go",
+		"alpha/enum.mode_test.go",
+		"alpha/enum.kind.go",
+		"alpha/enum.get_node_kind.go",
+		"beta/cdtp.go",
+		"beta/command.go",
+		"beta/enum.mode.go",
+		"beta/frames/cdtp.go",
+		"socket/cdtp.alpha.go",
+		"socket/cdtp.alpha_test.go",
+		"socket/cdtp.beta.go",
+		"socket/cdtp.beta_test.go",
+		"socket/cdtp.beta.frames.go",
+	} {
+		if _, ok := files[path]; !ok {
+			t.Errorf("Expected file '%s' to be generated", path)
+		}
+	}
+	for path, src := range files {
+		if !strings.HasPrefix(string(src), header) {
+			t.Errorf("Expected '%s' to start with the generated code header", path)
+		}
+	}
+
+	expectContains(t, files, "alpha/cdtp.go",
+		"Package alpha provides type definitions for use with the Chrome Alpha protocol",
+		`"example.com/protocol/beta"`,
+		"type NodeID int",
+		"type Quad []float64",
+		"Node is a node.",
+		"Mode ModeEnum `json:\"mode,omitempty\"`",
+		"Frame *beta.Frame `json:\"frame,omitempty\"`",
+		"Kind KindEnum `json:\"kind\"`",
+		"EXPERIMENTAL.",
+		"https://chromedevtools.github.io/devtools-protocol/1-3/Alpha/#type-Node",
+	)
+	expectContains(t, files, "alpha/command.go",
+		"type GetNodeParams struct",
+		"Kind GetNodeKindEnum `json:\"kind\"`",
+		"Node *Node `json:\"node\"`",
+		"URL string `json:\"url\"`",
+		"Err error `json:\"-\"`",
+	)
+	expectContains(t, files, "alpha/event.go",
+		"type NodeAddedEvent struct",
+		"Frames []*beta.Frame `json:\"frames\"`",
+	)
+	expectContains(t, files, "alpha/enum.mode.go",
+		"Mode provides named access to the ModeEnum values.",
+		"ModeEnum represents rendering mode. Allowed values:",
+		`modeDarkMode: "dark-mode",`,
+	)
+
+	// Beta can't import Alpha without creating a cycle, so the Alpha types it
+	// uses are duplicated.
+	expectContains(t, files, "beta/cdtp.go",
+		"This is a duplicate of Alpha.NodeId to",
+		"Owner NodeID `json:\"owner,omitempty\"`",
+		"Mode ModeEnum `json:\"mode\"`",
+	)
+	if strings.Contains(string(files["beta/cdtp.go"]), "example.com/protocol/alpha") {
+		t.Errorf("Expected beta not to import alpha")
+	}
+
+	expectContains(t, files, "socket/cdtp.alpha.go",
+		"type AlphaProtocol struct",
+		"Enable enables the alpha domain.",
+		"func (protocol *AlphaProtocol) Enable() <-chan *alpha.EnableResult",
+		"func (protocol *AlphaProtocol) GetNodeContext(",
+		`NewCommand(protocol.Socket, "Alpha.getNode", params)`,
+		"result.Err = json.Unmarshal(response.Result, &result)",
+		"Alpha.nodeAdded fires",
+		"func (protocol *AlphaProtocol) OnNodeAdded(",
+	)
+	expectContains(t, files, "socket/cdtp.alpha_test.go",
+		"func TestAlphaGetNode(t *testing.T)",
+		"NodeID: 1,",
+		"Kind:   alpha.GetNodeKind.Deep,",
+		`URL: "URL",`,
+		"func TestAlphaOnNodeAdded(t *testing.T)",
+	)
+	expectContains(t, files, "socket/cdtp.beta.go",
+		"BetaProtocol provides a namespace for the Chrome Beta protocol methods.",
+		"GetFrame performs the Beta.getFrame command.",
+	)
+}
+
+func TestGenerateDomains(t *testing.T) {
+	files := generate(t, "Beta")
+	for path := range files {
+		if !strings.HasPrefix(path, "beta/") && !strings.HasPrefix(path, "socket/cdtp.beta") {
+			t.Errorf("Expected only Beta files, got '%s'", path)
+		}
+	}
+
+	protocol, _ := LoadProtocol("testdata/protocol.json")
+	gen := &Generator{Protocol: protocol}
+	if _, err := gen.Generate("Gamma"); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+}
+
+func TestGenerateEnum(t *testing.T) {
+	protocol, _ := LoadProtocol("testdata/protocol.json")
+	gen := &Generator{Protocol: protocol, Version: "tot"}
+
+	files, err := gen.GenerateEnum("Alpha.Mode")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+	if 2 != len(files) {
+		t.Errorf("Expected 2 files, got %d", len(files))
+	}
+	expectContains(t, files, "alpha/enum.mode.go", "type ModeEnum int")
+	expectContains(t, files, "alpha/enum.mode_test.go", "func TestEnumMode(t *testing.T)")
+
+	if _, err := gen.GenerateEnum("Alpha.Node"); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cdtpgen")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+	defer os.RemoveAll(dir)
+
+	gen := &Generator{ImportPath: "example.com/protocol", Version: "tot"}
+	if err := run(gen, "", "", dir, false, nil, nil); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+	if err := run(gen, "testdata/protocol.json", "", dir, false, []string{"Alpha"}, nil); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "alpha", "cdtp.go")); nil != err {
+		t.Errorf("Expected alpha/cdtp.go to be written, got error: '%s'", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "socket", "cdtp.alpha_test.go")); nil != err {
+		t.Errorf("Expected socket/cdtp.alpha_test.go to be written, got error: '%s'", err)
+	}
+}
+
+func TestGenerateStable(t *testing.T) {
+	protocol, err := LoadProtocol("testdata/protocol.json")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+	gen := &Generator{
+		Protocol:    Stable(protocol),
+		ImportPath:  "example.com/protocol",
+		Version:     "1-3",
+		Protocoller: true,
+	}
+	files, err := gen.Generate()
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+
+	for _, path := range []string{"beta/cdtp.go", "socket/cdtp.beta.go"} {
+		if _, ok := files[path]; ok {
+			t.Errorf("Expected experimental domain Beta to be omitted, got '%s'", path)
+		}
+	}
+	if strings.Contains(string(files["alpha/command.go"]), "ResetResult") {
+		t.Errorf("Expected experimental command Alpha.reset to be omitted")
+	}
+	if strings.Contains(string(files["alpha/command.go"]), "Depth") {
+		t.Errorf("Expected optional experimental parameter depth to be omitted")
+	}
+
+	// Beta.Frame is still referenced by the stable surface, so it is
+	// duplicated into alpha.
+	expectContains(t, files, "alpha/cdtp.go",
+		"Frame *Frame `json:\"frame,omitempty\"`",
+		"This is a duplicate of Beta.Frame to",
+		"Bounds Quad `json:\"bounds\"`",
+	)
+
+	expectContains(t, files, "socket/interface.protocoller.go",
+		"type Protocoller interface",
+		"Alpha() *AlphaProtocol",
+	)
+	expectContains(t, files, "socket/socket.protocoller.go",
+		"&AlphaProtocol{Socket: socket},",
+		"func (protocols *protocols) Alpha() *AlphaProtocol",
+	)
+	expectContains(t, files, "tab.socket.protocoller.go",
+		`"example.com/protocol/socket"`,
+		"func (tab *Tab) Alpha() *socket.AlphaProtocol",
+	)
+}
+
+func TestGenerateSurface(t *testing.T) {
+	protocol, err := LoadProtocol("testdata/protocol.json")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+	gen := &Generator{
+		Protocol:   Stable(protocol),
+		ImportPath: "example.com/protocol",
+		Version:    "1-3",
+		Surface:    true,
+	}
+	files, err := gen.Generate()
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+
+	expectContains(t, files, "socket/socket.protocol_surface.go",
+		"var Surface = &ProtocolSurface{",
+		`"Alpha.nodeAdded",`,
+		`"Alpha.enable",`,
+		`"Alpha.getNode",`,
+	)
+	if strings.Contains(string(files["socket/socket.protocol_surface.go"]), "Alpha.reset") {
+		t.Errorf("Expected experimental command Alpha.reset to be omitted from the surface")
+	}
+}
+
+func TestGenerateStableSurface(t *testing.T) {
+	protocol, err := LoadProtocol("testdata/protocol.json")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+	gen := &Generator{
+		Protocol:   protocol,
+		ImportPath: "example.com/protocol",
+		Version:    "tot",
+		Surface:    true,
+	}
+	files, err := gen.Generate()
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+
+	parts := strings.SplitN(string(files["socket/socket.protocol_surface.go"]), "var StableSurface = &ProtocolSurface{", 2)
+	if 2 != len(parts) {
+		t.Fatalf("Expected the StableSurface variable, got:\n%s", files["socket/socket.protocol_surface.go"])
+	}
+	for _, name := range []string{`"Alpha.reset",`, `"Beta.getFrame",`} {
+		if !strings.Contains(parts[0], name) {
+			t.Errorf("Expected %s in the surface", name)
+		}
+		if strings.Contains(parts[1], name) {
+			t.Errorf("Expected experimental %s to be omitted from the stable surface", name)
+		}
+	}
+	for _, name := range []string{`"Alpha.enable",`, `"Alpha.nodeAdded",`} {
+		if !strings.Contains(parts[1], name) {
+			t.Errorf("Expected %s in the stable surface", name)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"sort"
+)
+
+/*
+importGraph tracks which domain packages may import which other domain
+packages. The protocol definitions contain circular references between domains
+which Go packages can't express, so any reference that would close an import
+cycle is rejected and the referenced type is duplicated into the referencing
+package instead.
+*/
+type importGraph struct {
+	allowed map[string]map[string]bool
+}
+
+/*
+newImportGraph builds the import graph for all domains in a protocol. Edges are
+considered in sorted order so the result is stable between runs.
+*/
+func newImportGraph(protocol *Protocol) *importGraph {
+	graph := &importGraph{allowed: map[string]map[string]bool{}}
+
+	sources := []string{}
+	refs := map[string][]string{}
+	omitted := map[string]bool{}
+	for _, domain := range protocol.Domains {
+		sources = append(sources, domain.Domain)
+		refs[domain.Domain] = domainRefs(domain)
+		omitted[domain.Domain] = domain.Omitted
+	}
+	sort.Strings(sources)
+
+	for _, source := range sources {
+		if omitted[source] {
+			continue
+		}
+		for _, target := range refs[source] {
+			if !omitted[target] && !graph.reaches(target, source) {
+				if nil == graph.allowed[source] {
+					graph.allowed[source] = map[string]bool{}
+				}
+				graph.allowed[source][target] = true
+			}
+		}
+	}
+	return graph
+}
+
+/*
+Allowed returns whether the source domain package may import the target domain
+package.
+*/
+func (graph *importGraph) Allowed(source, target string) bool {
+	return graph.allowed[source][target]
+}
+
+/*
+reaches returns whether the target domain can be reached from the source domain
+using the allowed edges.
+*/
+func (graph *importGraph) reaches(source, target string) bool {
+	visited := map[string]bool{}
+	stack := []string{source}
+	for len(stack) > 0 {
+		domain := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if domain == target {
+			return true
+		}
+		if visited[domain] {
+			continue
+		}
+		visited[domain] = true
+		for next := range graph.allowed[domain] {
+			stack = append(stack, next)
+		}
+	}
+	return false
+}
+
+/*
+domainRefs returns the sorted list of other domains referenced by a domain.
+*/
+func domainRefs(domain *Domain) []string {
+	found := map[string]bool{}
+	addProps := func(props []*Property) {
+		for _, prop := range props {
+			ref := prop.Ref
+			if nil != prop.Items && "" != prop.Items.Ref {
+				ref = prop.Items.Ref
+			}
+			if "" == ref {
+				continue
+			}
+			if refDomain, _ := splitRef(domain.Domain, ref); refDomain != domain.Domain {
+				found[refDomain] = true
+			}
+		}
+	}
+	for _, typ := range domain.Types {
+		addProps(typ.Properties)
+		if nil != typ.Items {
+			addProps([]*Property{{Ref: typ.Items.Ref}})
+		}
+	}
+	for _, command := range domain.Commands {
+		addProps(command.Parameters)
+		addProps(command.Returns)
+	}
+	for _, event := range domain.Events {
+		addProps(event.Parameters)
+	}
+
+	refs := []string{}
+	for ref := range found {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	return refs
+}
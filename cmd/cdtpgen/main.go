@@ -0,0 +1,117 @@
+/*
+Command cdtpgen generates Chrome DevTools Protocol domain packages and socket
+protocol wrappers from the official browser_protocol.json and js_protocol.json
+definition files.
+
+For each domain it generates the type, parameter, result and event structs, the
+enums, the socket *Protocol wrapper and the tests for all of them. Types that
+would introduce an import cycle between domain packages are duplicated into the
+referencing package.
+
+Usage:
+
+	cdtpgen -browser browser_protocol.json -js js_protocol.json [flags]
+
+Flags:
+
+	-browser  Path to browser_protocol.json.
+	-js       Path to js_protocol.json.
+	-out      Protocol version root to write the packages to. Defaults to '.'.
+	-import   Import path of the protocol version root.
+	-version  Protocol version used in documentation URLs. Defaults to 'tot'.
+	-domains  Comma-separated list of domains to generate. Defaults to all.
+	-enums    Comma-separated list of named enum types, e.g. 'Network.ResourceType',
+	          to generate into existing domain packages.
+	-stable   Limit the generated API to the stable, non-experimental protocol
+	          surface.
+	-protocoller
+	          Also generate the Protocoller interface, its socket implementation
+	          and the Tab accessors.
+	-surface  Also generate the socket Surface and StableSurface variables
+	          listing the methods and events of the generated domains, used by
+	          the compatibility check.
+
+It is normally invoked through go generate, see tot/generate.go and
+v1_3/generate.go.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	browser := flag.String("browser", "", "path to browser_protocol.json")
+	js := flag.String("js", "", "path to js_protocol.json")
+	out := flag.String("out", ".", "protocol version root to write the packages to")
+	importPath := flag.String("import", "github.com/mkenney/go-chrome/tot", "import path of the protocol version root")
+	version := flag.String("version", "tot", "protocol version used in documentation URLs")
+	domains := flag.String("domains", "", "comma-separated list of domains to generate, defaults to all")
+	enums := flag.String("enums", "", "comma-separated list of named enum types to generate into existing domain packages")
+	stable := flag.Bool("stable", false, "limit the generated API to the stable protocol surface")
+	protocoller := flag.Bool("protocoller", false, "generate the Protocoller interface, socket implementation and Tab accessors")
+	surface := flag.Bool("surface", false, "generate the socket Surface of the generated domains")
+	flag.Parse()
+
+	gen := &Generator{
+		ImportPath:  *importPath,
+		Version:     *version,
+		Protocoller: *protocoller,
+		Surface:     *surface,
+	}
+	if err := run(gen, *browser, *js, *out, *stable, split(*domains), split(*enums)); nil != err {
+		fmt.Fprintf(os.Stderr, "cdtpgen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+/*
+run loads the protocol definitions and writes the files generated by gen.
+*/
+func run(gen *Generator, browser, js, out string, stable bool, domains, enums []string) error {
+	if "" == browser && "" == js {
+		return fmt.Errorf("at least one of -browser or -js is required")
+	}
+	protocol, err := LoadProtocol(browser, js)
+	if nil != err {
+		return err
+	}
+	if stable {
+		protocol = Stable(protocol)
+	}
+	gen.Protocol = protocol
+	for _, enum := range enums {
+		files, err := gen.GenerateEnum(enum)
+		if nil != err {
+			return err
+		}
+		if err := Write(out, files); nil != err {
+			return err
+		}
+	}
+	if len(enums) > 0 && 0 == len(domains) {
+		return nil
+	}
+
+	files, err := gen.Generate(domains...)
+	if nil != err {
+		return err
+	}
+	return Write(out, files)
+}
+
+/*
+split splits a comma-separated flag value, ignoring empty entries.
+*/
+func split(value string) []string {
+	result := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); "" != item {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+goType describes the Go representation of a protocol type.
+*/
+type goType struct {
+	// Expr is the Go type expression. Types from other packages are qualified
+	// with a placeholder that is replaced once the imports are known.
+	Expr string
+
+	// Kind is the underlying Go kind used to generate test values: one of
+	// string, int, float64, bool, enum or other.
+	Kind string
+
+	// Enum is set for enums declared in the current package.
+	Enum *enumDef
+}
+
+/*
+enumDef describes an enum declared in a generated package.
+*/
+type enumDef struct {
+	// Name is the name of the exported enum variable, e.g. 'Behavior'. The
+	// type is named Name + "Enum".
+	Name        string
+	Values      []string
+	Description string
+	URL         string
+}
+
+/*
+dupType describes a type from another domain that is duplicated into the
+current package to avoid an import cycle.
+*/
+type dupType struct {
+	Domain string
+	Type   *Type
+	Name   string
+}
+
+/*
+pkgGen holds the state for generating a single domain package.
+*/
+type pkgGen struct {
+	gen    *Generator
+	domain *Domain
+	name   string
+
+	idents   map[string]bool
+	enums    []*enumDef
+	dups     []*dupType
+	dupNames map[string]string
+	imports  map[string]bool
+}
+
+/*
+newPkgGen returns a package generator for the specified domain with all of the
+domain's own identifiers reserved.
+*/
+func newPkgGen(gen *Generator, domain *Domain) *pkgGen {
+	pkg := &pkgGen{
+		gen:      gen,
+		domain:   domain,
+		name:     PackageName(domain.Domain),
+		idents:   map[string]bool{},
+		dupNames: map[string]string{},
+		imports:  map[string]bool{},
+	}
+	for _, typ := range domain.Types {
+		pkg.idents[GoName(typ.ID)] = true
+		if isEnum(typ) {
+			pkg.idents[GoName(typ.ID)+"Enum"] = true
+		}
+	}
+	for _, command := range domain.Commands {
+		pkg.idents[GoName(command.Name)+"Params"] = true
+		pkg.idents[GoName(command.Name)+"Result"] = true
+	}
+	for _, event := range domain.Events {
+		pkg.idents[GoName(event.Name)+"Event"] = true
+	}
+	return pkg
+}
+
+/*
+isEnum returns whether a named type is a string enum.
+*/
+func isEnum(typ *Type) bool {
+	return "string" == typ.Type && len(typ.Enum) > 0
+}
+
+/*
+isStruct returns whether a named type is an object with known properties.
+*/
+func isStruct(typ *Type) bool {
+	return "object" == typ.Type && len(typ.Properties) > 0
+}
+
+/*
+typeName returns the Go name of a named protocol type.
+*/
+func typeName(typ *Type) string {
+	if isEnum(typ) {
+		return GoName(typ.ID) + "Enum"
+	}
+	return GoName(typ.ID)
+}
+
+/*
+primitive returns the Go type for a protocol primitive type.
+*/
+func primitive(typ string) goType {
+	switch typ {
+	case "string", "binary":
+		return goType{Expr: "string", Kind: "string"}
+	case "integer":
+		return goType{Expr: "int", Kind: "int"}
+	case "number":
+		return goType{Expr: "float64", Kind: "float64"}
+	case "boolean":
+		return goType{Expr: "bool", Kind: "bool"}
+	case "object":
+		return goType{Expr: "map[string]interface{}", Kind: "other"}
+	}
+	return goType{Expr: "interface{}", Kind: "other"}
+}
+
+/*
+underlying returns the Go type a named protocol type is declared as.
+*/
+func (pkg *pkgGen) underlying(domain string, typ *Type) goType {
+	if "array" == typ.Type && nil != typ.Items {
+		elem := pkg.itemsType(domain, typ.ID, typ.ID, typ.Items, typ.Description, "")
+		return goType{Expr: "[]" + elem.Expr, Kind: "other"}
+	}
+	return primitive(typ.Type)
+}
+
+/*
+propType returns the Go type of a property. Inline enums are declared in the
+current package and named after the property, or after the owner and the
+property if the property name is already taken.
+*/
+func (pkg *pkgGen) propType(domain, owner string, prop *Property, url string) goType {
+	if "" != prop.Ref {
+		return pkg.refType(domain, prop.Ref)
+	}
+	if len(prop.Enum) > 0 {
+		enum := pkg.inlineEnum(owner, prop.Name, prop.Enum, prop.Description, url)
+		return goType{Expr: enum.Name + "Enum", Kind: "enum", Enum: enum}
+	}
+	if "array" == prop.Type && nil != prop.Items {
+		elem := pkg.itemsType(domain, owner, prop.Name, prop.Items, prop.Description, url)
+		return goType{Expr: "[]" + elem.Expr, Kind: "other"}
+	}
+	return primitive(prop.Type)
+}
+
+/*
+itemsType returns the Go type of an array element.
+*/
+func (pkg *pkgGen) itemsType(domain, owner, name string, items *Items, description, url string) goType {
+	if "" != items.Ref {
+		return pkg.refType(domain, items.Ref)
+	}
+	if len(items.Enum) > 0 {
+		enum := pkg.inlineEnum(owner, name, items.Enum, description, url)
+		return goType{Expr: enum.Name + "Enum", Kind: "enum", Enum: enum}
+	}
+	return primitive(items.Type)
+}
+
+/*
+refType resolves a type reference made from the specified domain. References to
+objects resolve to pointers.
+*/
+func (pkg *pkgGen) refType(domain, ref string) goType {
+	refDomain, id := splitRef(domain, ref)
+	def := pkg.gen.Protocol.Domain(refDomain)
+	var typ *Type
+	if nil != def {
+		typ = def.Type(id)
+	}
+	if nil == typ {
+		pkg.gen.errorf("%s: unknown type reference '%s'", pkg.domain.Domain, ref)
+		return goType{Expr: "interface{}", Kind: "other"}
+	}
+
+	result := goType{Expr: typeName(typ), Kind: "other"}
+	switch {
+	case isEnum(typ):
+		result.Kind = "enum"
+	case "string" == typ.Type || "integer" == typ.Type || "number" == typ.Type || "boolean" == typ.Type:
+		result.Kind = primitive(typ.Type).Kind
+	}
+
+	switch {
+	case refDomain == pkg.domain.Domain:
+		if isEnum(typ) {
+			result.Enum = pkg.namedEnum(typ)
+		}
+	case pkg.gen.graph.Allowed(pkg.domain.Domain, refDomain):
+		pkg.imports[refDomain] = true
+		result.Expr = qualifier(refDomain) + result.Expr
+	default:
+		name := pkg.duplicate(refDomain, typ)
+		result.Expr = name
+		if isEnum(typ) {
+			result.Expr = name + "Enum"
+			result.Enum = pkg.findEnum(name)
+		}
+	}
+
+	if isStruct(typ) {
+		result.Expr = "*" + result.Expr
+	}
+	return result
+}
+
+/*
+qualifier returns the placeholder used to qualify identifiers from another
+domain package.
+*/
+func qualifier(domain string) string {
+	return "\x00" + domain + "\x00."
+}
+
+/*
+duplicate declares a copy of a type from another domain in the current package
+and returns its name. Duplicates are named after the original type unless the
+name is taken, in which case the name is prefixed with the original domain.
+*/
+func (pkg *pkgGen) duplicate(domain string, typ *Type) string {
+	key := domain + "." + typ.ID
+	if name, ok := pkg.dupNames[key]; ok {
+		return name
+	}
+
+	name := GoName(typ.ID)
+	if pkg.idents[name] || pkg.idents[name+"Enum"] {
+		name = GoName(domain) + name
+	}
+	pkg.idents[name] = true
+	pkg.dupNames[key] = name
+
+	if isEnum(typ) {
+		pkg.idents[name+"Enum"] = true
+		pkg.enums = append(pkg.enums, &enumDef{
+			Name:        name,
+			Values:      typ.Enum,
+			Description: typ.Description,
+			URL:         pkg.gen.url(domain, "type-"+typ.ID),
+		})
+	} else {
+		pkg.dups = append(pkg.dups, &dupType{Domain: domain, Type: typ, Name: name})
+	}
+	return name
+}
+
+/*
+namedEnum returns the enum definition for a named enum type in the current
+domain, declaring it the first time it is used.
+*/
+func (pkg *pkgGen) namedEnum(typ *Type) *enumDef {
+	name := GoName(typ.ID)
+	if enum := pkg.findEnum(name); nil != enum {
+		return enum
+	}
+	enum := &enumDef{
+		Name:        name,
+		Values:      typ.Enum,
+		Description: typ.Description,
+		URL:         pkg.gen.url(pkg.domain.Domain, "type-"+typ.ID),
+	}
+	pkg.enums = append(pkg.enums, enum)
+	return enum
+}
+
+/*
+inlineEnum returns the enum definition for an inline property enum. Enums with
+the same name and values are shared.
+*/
+func (pkg *pkgGen) inlineEnum(owner, prop string, values []string, description, url string) *enumDef {
+	candidates := []string{GoName(prop), GoName(owner) + GoName(prop)}
+	for a := 2; ; a++ {
+		var name string
+		if a-2 < len(candidates) {
+			name = candidates[a-2]
+		} else {
+			name = fmt.Sprintf("%s%s%d", GoName(owner), GoName(prop), a-len(candidates))
+		}
+
+		if enum := pkg.findEnum(name); nil != enum {
+			if strings.Join(enum.Values, "\x00") == strings.Join(values, "\x00") {
+				return enum
+			}
+			continue
+		}
+		if pkg.idents[name] || pkg.idents[name+"Enum"] {
+			continue
+		}
+
+		pkg.idents[name] = true
+		pkg.idents[name+"Enum"] = true
+		enum := &enumDef{
+			Name:        name,
+			Values:      values,
+			Description: description,
+			URL:         url,
+		}
+		pkg.enums = append(pkg.enums, enum)
+		return enum
+	}
+}
+
+/*
+findEnum returns the named enum declared in the current package, or nil.
+*/
+func (pkg *pkgGen) findEnum(name string) *enumDef {
+	for _, enum := range pkg.enums {
+		if name == enum.Name {
+			return enum
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+/*
+initialisms maps capitalized words to the form used by the Go API, following
+the golint initialism conventions.
+*/
+var initialisms = map[string]string{
+	"Api":   "API",
+	"Cpu":   "CPU",
+	"Css":   "CSS",
+	"Db":    "DB",
+	"Dom":   "DOM",
+	"Gpu":   "GPU",
+	"Html":  "HTML",
+	"Http":  "HTTP",
+	"Https": "HTTPS",
+	"Id":    "ID",
+	"Ids":   "IDs",
+	"Io":    "IO",
+	"Ip":    "IP",
+	"Js":    "JS",
+	"Json":  "JSON",
+	"Sql":   "SQL",
+	"Ssl":   "SSL",
+	"Tls":   "TLS",
+	"Uri":   "URI",
+	"Url":   "URL",
+	"Urls":  "URLs",
+	"Uuid":  "UUID",
+	"Xml":   "XML",
+}
+
+/*
+splitWords splits a protocol identifier into words. Camel case boundaries,
+acronyms and any non-alphanumeric characters are treated as word separators.
+*/
+func splitWords(name string) []string {
+	words := []string{}
+	runes := []rune(name)
+	word := []rune{}
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = []rune{}
+		}
+	}
+	for a, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if a > 0 && unicode.IsUpper(r) && len(word) > 0 {
+			prev := runes[a-1]
+			nextIsLower := a+1 < len(runes) && unicode.IsLower(runes[a+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				flush()
+			}
+		}
+		word = append(word, r)
+	}
+	flush()
+	return words
+}
+
+/*
+GoName converts a protocol identifier into an exported Go identifier.
+*/
+func GoName(name string) string {
+	result := ""
+	for _, word := range splitWords(name) {
+		word = strings.ToUpper(word[:1]) + word[1:]
+		if initialism, ok := initialisms[word]; ok {
+			word = initialism
+		}
+		result += word
+	}
+	if "" == result {
+		return "Empty"
+	}
+	if unicode.IsDigit([]rune(result)[0]) {
+		result = "Value" + result
+	}
+	return result
+}
+
+/*
+lowerName converts an exported Go identifier into an unexported identifier,
+lower-casing a leading acronym.
+*/
+func lowerName(name string) string {
+	runes := []rune(name)
+	for a := range runes {
+		if !unicode.IsUpper(runes[a]) {
+			break
+		}
+		if a > 0 && a+1 < len(runes) && unicode.IsLower(runes[a+1]) {
+			break
+		}
+		runes[a] = unicode.ToLower(runes[a])
+	}
+	return string(runes)
+}
+
+/*
+snakeName converts an identifier into a lower snake case file name component.
+*/
+func snakeName(name string) string {
+	words := splitWords(name)
+	for a, word := range words {
+		words[a] = strings.ToLower(word)
+	}
+	return strings.Join(words, "_")
+}
+
+/*
+PackagePath returns the package directory for a domain relative to the version
+root, e.g. 'dom/snapshot' for DOMSnapshot.
+*/
+func PackagePath(domain string) string {
+	words := splitWords(domain)
+	for a, word := range words {
+		words[a] = strings.ToLower(word)
+	}
+	return strings.Join(words, "/")
+}
+
+/*
+PackageName returns the Go package name for a domain, e.g. 'snapshot' for
+DOMSnapshot.
+*/
+func PackageName(domain string) string {
+	words := splitWords(domain)
+	return strings.ToLower(words[len(words)-1])
+}
+
+/*
+SocketFile returns the socket package file name for a domain, e.g.
+'cdtp.dom.snapshot.go' for DOMSnapshot.
+*/
+func SocketFile(domain string) string {
+	return "cdtp." + strings.Replace(PackagePath(domain), "/", ".", -1) + ".go"
+}
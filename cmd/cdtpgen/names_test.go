@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestGoName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"nodeId":            "NodeID",
+		"urlPattern":        "URLPattern",
+		"DOMSnapshot":       "DOMSnapshot",
+		"getHTTPHeaders":    "GetHTTPHeaders",
+		"backendNodeIds":    "BackendNodeIDs",
+		"dark-mode":         "DarkMode",
+		"no-referrer-when":  "NoReferrerWhen",
+		"3d":                "Value3d",
+		"":                  "Empty",
+		"takeHeapSnapshot":  "TakeHeapSnapshot",
+		"requestWillBeSent": "RequestWillBeSent",
+	} {
+		if result := GoName(name); expected != result {
+			t.Errorf("GoName(%q): expected '%s', got '%s'", name, expected, result)
+		}
+	}
+}
+
+func TestLowerName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"Behavior":   "behavior",
+		"URLPattern": "urlPattern",
+		"DOMStorage": "domStorage",
+		"ID":         "id",
+	} {
+		if result := lowerName(name); expected != result {
+			t.Errorf("lowerName(%q): expected '%s', got '%s'", name, expected, result)
+		}
+	}
+}
+
+func TestPackagePath(t *testing.T) {
+	for domain, expected := range map[string][]string{
+		"DOMSnapshot":          {"dom/snapshot", "snapshot", "cdtp.dom.snapshot.go"},
+		"IndexedDB":            {"indexed/db", "db", "cdtp.indexed.db.go"},
+		"HeadlessExperimental": {"headless/experimental", "experimental", "cdtp.headless.experimental.go"},
+		"Page":                 {"page", "page", "cdtp.page.go"},
+	} {
+		if result := PackagePath(domain); expected[0] != result {
+			t.Errorf("PackagePath(%q): expected '%s', got '%s'", domain, expected[0], result)
+		}
+		if result := PackageName(domain); expected[1] != result {
+			t.Errorf("PackageName(%q): expected '%s', got '%s'", domain, expected[1], result)
+		}
+		if result := SocketFile(domain); expected[2] != result {
+			t.Errorf("SocketFile(%q): expected '%s', got '%s'", domain, expected[2], result)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+)
+
+/*
+Protocol represents a Chrome DevTools Protocol definition file such as
+browser_protocol.json or js_protocol.json.
+*/
+type Protocol struct {
+	Version struct {
+		Major string `json:"major"`
+		Minor string `json:"minor"`
+	} `json:"version"`
+	Domains []*Domain `json:"domains"`
+}
+
+/*
+Domain represents a protocol domain definition.
+*/
+type Domain struct {
+	Domain       string     `json:"domain"`
+	Description  string     `json:"description"`
+	Experimental bool       `json:"experimental"`
+	Deprecated   bool       `json:"deprecated"`
+	Dependencies []string   `json:"dependencies"`
+	Types        []*Type    `json:"types"`
+	Commands     []*Command `json:"commands"`
+	Events       []*Event   `json:"events"`
+
+	// Omitted is set for domains that are excluded from the generated API.
+	// Types referenced from omitted domains are duplicated where they are
+	// used.
+	Omitted bool `json:"-"`
+}
+
+/*
+Type represents a named protocol type definition.
+*/
+type Type struct {
+	ID           string      `json:"id"`
+	Description  string      `json:"description"`
+	Type         string      `json:"type"`
+	Enum         []string    `json:"enum"`
+	Items        *Items      `json:"items"`
+	Properties   []*Property `json:"properties"`
+	Experimental bool        `json:"experimental"`
+	Deprecated   bool        `json:"deprecated"`
+}
+
+/*
+Property represents a type property, a command parameter or return value, or an
+event parameter.
+*/
+type Property struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Optional     bool     `json:"optional"`
+	Type         string   `json:"type"`
+	Ref          string   `json:"$ref"`
+	Enum         []string `json:"enum"`
+	Items        *Items   `json:"items"`
+	Experimental bool     `json:"experimental"`
+	Deprecated   bool     `json:"deprecated"`
+}
+
+/*
+Items describes the element type of an array.
+*/
+type Items struct {
+	Type string   `json:"type"`
+	Ref  string   `json:"$ref"`
+	Enum []string `json:"enum"`
+}
+
+/*
+Command represents a protocol method definition.
+*/
+type Command struct {
+	Name         string      `json:"name"`
+	Description  string      `json:"description"`
+	Parameters   []*Property `json:"parameters"`
+	Returns      []*Property `json:"returns"`
+	Redirect     string      `json:"redirect"`
+	Experimental bool        `json:"experimental"`
+	Deprecated   bool        `json:"deprecated"`
+}
+
+/*
+Event represents a protocol event definition.
+*/
+type Event struct {
+	Name         string      `json:"name"`
+	Description  string      `json:"description"`
+	Parameters   []*Property `json:"parameters"`
+	Experimental bool        `json:"experimental"`
+	Deprecated   bool        `json:"deprecated"`
+}
+
+/*
+LoadProtocol reads and merges the domains defined in the specified protocol
+files.
+*/
+func LoadProtocol(files ...string) (*Protocol, error) {
+	merged := &Protocol{}
+	for _, file := range files {
+		if "" == file {
+			continue
+		}
+		data, err := ioutil.ReadFile(file)
+		if nil != err {
+			return nil, err
+		}
+		protocol := &Protocol{}
+		if err := json.Unmarshal(data, protocol); nil != err {
+			return nil, err
+		}
+		if "" == merged.Version.Major {
+			merged.Version = protocol.Version
+		}
+		merged.Domains = append(merged.Domains, protocol.Domains...)
+	}
+	return merged, nil
+}
+
+/*
+Domain returns the named domain definition, or nil if it does not exist.
+*/
+func (protocol *Protocol) Domain(name string) *Domain {
+	for _, domain := range protocol.Domains {
+		if name == domain.Domain {
+			return domain
+		}
+	}
+	return nil
+}
+
+/*
+Type returns the named type definition, or nil if it does not exist.
+*/
+func (domain *Domain) Type(id string) *Type {
+	for _, typ := range domain.Types {
+		if id == typ.ID {
+			return typ
+		}
+	}
+	return nil
+}
+
+/*
+splitRef splits a type reference into its domain and type ID. Local references
+are resolved against the specified domain.
+*/
+func splitRef(domain, ref string) (string, string) {
+	if idx := strings.Index(ref, "."); idx >= 0 {
+		return ref[:idx], ref[idx+1:]
+	}
+	return domain, ref
+}
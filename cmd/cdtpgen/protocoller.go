@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"text/template"
+)
+
+/*
+protocollerDomain is the template data for a domain in the Protocoller files.
+*/
+type protocollerDomain struct {
+	Name  string
+	Field string
+}
+
+/*
+writeProtocoller generates the Protocoller interface, the socket protocols
+struct that implements it and the Tab accessors for the specified domains.
+*/
+func (gen *Generator) writeProtocoller(domains []*Domain) {
+	data := map[string]interface{}{
+		"URL":     "https://chromedevtools.github.io/devtools-protocol/" + gen.Version + "/",
+		"Import":  gen.ImportPath + "/" + gen.SocketDir,
+		"Domains": []protocollerDomain{},
+	}
+	for _, domain := range domains {
+		data["Domains"] = append(data["Domains"].([]protocollerDomain), protocollerDomain{
+			Name:  GoName(domain.Domain),
+			Field: lowerName(GoName(domain.Domain)),
+		})
+	}
+
+	for path, tmpl := range map[string]*template.Template{
+		gen.SocketDir + "/interface.protocoller.go": protocollerInterfaceTemplate,
+		gen.SocketDir + "/socket.protocoller.go":    protocollerSocketTemplate,
+		"tab.socket.protocoller.go":                 protocollerTabTemplate,
+	} {
+		buf := &bytes.Buffer{}
+		buf.WriteString(header)
+		if err := tmpl.Execute(buf, data); nil != err {
+			gen.errorf("%s: %s", path, err)
+			continue
+		}
+		gen.addFile(path, buf.Bytes())
+	}
+}
+
+var protocollerInterfaceTemplate = template.Must(template.New("interface").Parse(`package socket
+
+/*
+Protocoller defines the Chrome DevTools Protocol API methods
+
+{{.URL}}
+*/
+type Protocoller interface {
+{{- range .Domains}}
+	// {{.Name}} returns the {{.Name}}Protocol instance.
+	{{.Name}}() *{{.Name}}Protocol
+{{end -}}
+}
+`))
+
+var protocollerSocketTemplate = template.Must(template.New("socket").Parse(`package socket
+
+/*
+protocols holds the protocol API instances of a socket.
+*/
+type protocols struct {
+{{- range .Domains}}
+	{{.Field}} *{{.Name}}Protocol
+{{- end}}
+}
+
+/*
+newProtocols returns the protocol API instances for the specified socket.
+*/
+func newProtocols(socket Socketer) protocols {
+	return protocols{
+{{- range .Domains}}
+		{{.Field}}: &{{.Name}}Protocol{Socket: socket},
+{{- end}}
+	}
+}
+{{range .Domains}}
+/*
+{{.Name}} returns the {{.Name}}Protocol instance.
+
+{{.Name}} is a Protocoller implementation.
+*/
+func (protocols *protocols) {{.Name}}() *{{.Name}}Protocol {
+	return protocols.{{.Field}}
+}
+{{end -}}
+`))
+
+var protocollerTabTemplate = template.Must(template.New("tab").Parse(`package chrome
+
+import (
+	"{{.Import}}"
+)
+{{range .Domains}}
+/*
+{{.Name}} implements socket.Protocoller
+*/
+func (tab *Tab) {{.Name}}() *socket.{{.Name}}Protocol {
+	return tab.protocol.{{.Name}}()
+}
+{{end -}}
+`))
@@ -0,0 +1,446 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+/*
+socketData is the template data for a protocol wrapper and its tests.
+*/
+type socketData struct {
+	Package     string
+	Import      string
+	Domain      string
+	Name        string
+	Description string
+	URL         string
+	Commands    []*socketCommand
+	Events      []*socketEvent
+	JSON        bool
+}
+
+/*
+socketCommand is the template data for a single command wrapper.
+*/
+type socketCommand struct {
+	*commandInfo
+	Doc        string
+	ContextDoc string
+	Unmarshal  bool
+	Samples    []sample
+	Result     []sample
+	Check      *sample
+}
+
+/*
+socketEvent is the template data for a single event handler.
+*/
+type socketEvent struct {
+	*eventInfo
+	Doc          string
+	SubscribeDoc string
+	WaitDoc      string
+	Samples      []sample
+	Check        *sample
+}
+
+/*
+sample is a struct field initialized with a test value.
+*/
+type sample struct {
+	Field string
+	Value string
+}
+
+/*
+samples returns test values for the fields that have a simple Go type. Fields
+without a known test value are left at their zero value.
+*/
+func samples(pkg string, fields []field) []sample {
+	result := []sample{}
+	for _, f := range fields {
+		value := ""
+		switch f.Type.Kind {
+		case "string":
+			value = fmt.Sprintf("%q", f.Name)
+		case "int", "float64":
+			value = "1"
+		case "bool":
+			value = "true"
+		case "enum":
+			if nil != f.Type.Enum && len(f.Type.Enum.Values) > 0 {
+				value = fmt.Sprintf("%s.%s.%s", pkg, f.Type.Enum.Name, f.Type.Enum.members()[0].Field)
+			}
+		}
+		if "" != value && !strings.HasPrefix(f.Type.Expr, "[]") && !strings.HasPrefix(f.Type.Expr, "*") {
+			result = append(result, sample{Field: f.Name, Value: value})
+		}
+	}
+	return result
+}
+
+/*
+check returns the first sample, which the generated tests compare after a round
+trip through the mock socket.
+*/
+func check(samples []sample) *sample {
+	if 0 == len(samples) {
+		return nil
+	}
+	return &samples[0]
+}
+
+/*
+commandDoc returns the doc comment text for a command wrapper.
+*/
+func commandDoc(info *commandInfo) string {
+	if "" == info.Description || strings.HasPrefix(info.Description, "EXPERIMENTAL.") || strings.HasPrefix(info.Description, "DEPRECATED.") {
+		return strings.TrimSpace(fmt.Sprintf("%s performs the %s command. %s", info.Name, info.Method, info.Description))
+	}
+	return info.Name + " " + lowerFirst(info.Description)
+}
+
+/*
+eventDoc returns the doc comment text for an event handler.
+*/
+func eventDoc(info *eventInfo) string {
+	doc := fmt.Sprintf("On%s adds a handler to the %s event.", info.Name, info.Method)
+	description := info.Description
+	switch {
+	case strings.HasPrefix(description, "Fired "):
+		return fmt.Sprintf("%s %s fires %s", doc, info.Method, description[len("Fired "):])
+	case strings.HasPrefix(description, "Issued "):
+		return fmt.Sprintf("%s %s is issued %s", doc, info.Method, description[len("Issued "):])
+	case "" != description:
+		return doc + " " + description
+	}
+	return doc
+}
+
+/*
+subscribeDoc returns the doc comment text for an event subscription channel.
+*/
+func subscribeDoc(info *eventInfo) string {
+	return fmt.Sprintf("Subscribe%s returns a channel that receives the %s events. "+
+		"The channel buffers up to size events and is closed when the subscription is "+
+		"unsubscribed or the socket is stopped.", info.Name, info.Method)
+}
+
+/*
+waitDoc returns the doc comment text for an event waiter.
+*/
+func waitDoc(info *eventInfo) string {
+	return fmt.Sprintf("Wait%s waits for the next %s event for which all predicates return true. "+
+		"The handler is added before Wait%s returns and removed when an event is received. If the "+
+		"context is done or the socket is stopped first, the event error is set to a "+
+		"codes.SocketWaitCancelled error.", info.Name, info.Method, info.Name)
+}
+
+/*
+writeSocket generates the protocol wrapper and tests for a domain.
+*/
+func (gen *Generator) writeSocket(pkg *pkgGen, commands []*commandInfo, events []*eventInfo) {
+	domain := pkg.domain
+	data := &socketData{
+		Package: pkg.name,
+		Import:  gen.ImportPath + "/" + PackagePath(domain.Domain),
+		Domain:  domain.Domain,
+		Name:    GoName(domain.Domain),
+		URL:     gen.url(domain.Domain, ""),
+		JSON:    len(events) > 0,
+	}
+	data.Description = fmt.Sprintf("%sProtocol provides a namespace for the Chrome %s protocol methods.", data.Name, domain.Domain)
+	if "" != domain.Description {
+		data.Description += " " + annotate(domain.Description, false, false)
+	}
+	data.Description = annotate(data.Description, domain.Experimental, domain.Deprecated)
+
+	for _, info := range commands {
+		command := &socketCommand{
+			commandInfo: info,
+			Doc:         commandDoc(info),
+			ContextDoc: fmt.Sprintf(
+				"%sContext performs %s using the provided context. If the context is done before a response is received the command is discarded and the result error is set to a codes.SocketCommandCancelled error.",
+				info.Name,
+				info.Name,
+			),
+			Unmarshal: len(info.Returns) > 0,
+			Samples:   samples(pkg.name, info.Params),
+			Result:    samples(pkg.name, info.Returns),
+		}
+		command.Check = check(command.Result)
+		data.JSON = data.JSON || command.Unmarshal
+		data.Commands = append(data.Commands, command)
+	}
+	for _, info := range events {
+		event := &socketEvent{
+			eventInfo:    info,
+			Doc:          eventDoc(info),
+			SubscribeDoc: subscribeDoc(info),
+			WaitDoc:      waitDoc(info),
+			Samples:      samples(pkg.name, info.Params),
+		}
+		event.Check = check(event.Samples)
+		data.Events = append(data.Events, event)
+	}
+
+	funcs := template.FuncMap{"comment": blockComment}
+	base := gen.SocketDir + "/" + SocketFile(domain.Domain)
+	for path, tmpl := range map[string]string{
+		base: socketTemplate,
+		strings.TrimSuffix(base, ".go") + "_test.go": socketTestTemplate,
+	} {
+		buf := &bytes.Buffer{}
+		buf.WriteString(header)
+		if err := template.Must(template.New(path).Funcs(funcs).Parse(tmpl)).Execute(buf, data); nil != err {
+			gen.errorf("%s: %s", path, err)
+			continue
+		}
+		gen.addFile(path, buf.Bytes())
+	}
+}
+
+var socketTemplate = `package socket
+
+import (
+	"context"
+{{- if .JSON}}
+	"encoding/json"
+{{- end}}
+
+	"{{.Import}}"
+)
+
+{{comment .Description .URL -}}
+type {{.Name}}Protocol struct {
+	Socket Socketer
+}
+{{range .Commands}}
+{{comment .Doc .URL -}}
+{{- if .HasParams -}}
+func (protocol *{{$.Name}}Protocol) {{.Name}}(
+	params *{{$.Package}}.{{.Name}}Params,
+) <-chan *{{$.Package}}.{{.Name}}Result {
+	return protocol.{{.Name}}Context(context.Background(), params)
+}
+{{- else -}}
+func (protocol *{{$.Name}}Protocol) {{.Name}}() <-chan *{{$.Package}}.{{.Name}}Result {
+	return protocol.{{.Name}}Context(context.Background())
+}
+{{- end}}
+
+{{comment .ContextDoc .URL -}}
+func (protocol *{{$.Name}}Protocol) {{.Name}}Context(
+	ctx context.Context,
+{{- if .HasParams}}
+	params *{{$.Package}}.{{.Name}}Params,
+{{- end}}
+) <-chan *{{$.Package}}.{{.Name}}Result {
+	resultChan := make(chan *{{$.Package}}.{{.Name}}Result)
+	command := NewCommand(protocol.Socket, "{{.Method}}", {{if .HasParams}}params{{else}}nil{{end}})
+	result := &{{$.Package}}.{{.Name}}Result{}
+	responseChan := protocol.Socket.SendCommandContext(ctx, command)
+
+	go func() {
+		response := <-responseChan
+		if nil != command.Error() {
+			result.Err = command.Error()
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = NewProtocolError(command.Method(), response.Error)
+{{- if .Unmarshal}}
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+{{- end}}
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+{{end}}
+{{- range .Events}}
+{{comment .Doc .URL -}}
+func (protocol *{{$.Name}}Protocol) On{{.Name}}(
+	callback func(event *{{$.Package}}.{{.Name}}Event),
+) *Subscription {
+	handler := NewEventHandler(
+		"{{.Method}}",
+		func(response *Response) {
+			event := &{{$.Package}}.{{.Name}}Event{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = NewProtocolError(response.Method, response.Error)
+			}
+			callback(event)
+		},
+	)
+	return Subscribe(protocol.Socket, handler)
+}
+
+{{comment .SubscribeDoc .URL -}}
+func (protocol *{{$.Name}}Protocol) Subscribe{{.Name}}(
+	size int,
+) (<-chan *{{$.Package}}.{{.Name}}Event, *Subscription) {
+	eventChan := make(chan *{{$.Package}}.{{.Name}}Event, size)
+	stream := NewEventStream(protocol.Socket, func() { close(eventChan) })
+	return eventChan, stream.Subscribe(protocol.On{{.Name}}(
+		func(event *{{$.Package}}.{{.Name}}Event) {
+			stream.Send(func(done <-chan struct{}) {
+				select {
+				case eventChan <- event:
+				case <-done:
+				}
+			})
+		},
+	))
+}
+
+{{comment .WaitDoc .URL -}}
+func (protocol *{{$.Name}}Protocol) Wait{{.Name}}(
+	ctx context.Context,
+	predicates ...func(event *{{$.Package}}.{{.Name}}Event) bool,
+) <-chan *{{$.Package}}.{{.Name}}Event {
+	eventChan := make(chan *{{$.Package}}.{{.Name}}Event, 1)
+	waitChan := WaitFor(ctx, protocol.Socket, "{{.Method}}", func(raw *Event) bool {
+		event := &{{$.Package}}.{{.Name}}Event{}
+		json.Unmarshal([]byte(raw.Params), event)
+		for _, predicate := range predicates {
+			if !predicate(event) {
+				return false
+			}
+		}
+		return true
+	})
+
+	go func() {
+		raw := <-waitChan
+		event := &{{$.Package}}.{{.Name}}Event{}
+		json.Unmarshal([]byte(raw.Params), event)
+		event.Err = raw.Err
+		eventChan <- event
+		close(eventChan)
+	}()
+
+	return eventChan
+}
+{{end -}}
+`
+
+var socketTestTemplate = `package socket
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"{{.Import}}"
+)
+{{range .Commands}}
+func Test{{$.Name}}{{.Name}}(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/Test{{$.Name}}{{.Name}}")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+{{if .HasParams}}
+	params := &{{$.Package}}.{{.Name}}Params{
+{{- range .Samples}}
+		{{.Field}}: {{.Value}},
+{{- end}}
+	}
+	resultChan := mockSocket.{{$.Name}}().{{.Name}}(params)
+{{- else}}
+	resultChan := mockSocket.{{$.Name}}().{{.Name}}()
+{{- end}}
+	mockResult := &{{$.Package}}.{{.Name}}Result{
+{{- range .Result}}
+		{{.Field}}: {{.Value}},
+{{- end}}
+	}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+{{- with .Check}}
+	if mockResult.{{.Field}} != result.{{.Field}} {
+		t.Errorf("Expected %v, got %v", mockResult.{{.Field}}, result.{{.Field}})
+	}
+{{- end}}
+
+	resultChan = mockSocket.{{$.Name}}().{{.Name}}({{if .HasParams}}params{{end}})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(` + "`\"error data\"`" + `),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+{{end}}
+{{- range .Events}}
+func Test{{$.Name}}On{{.Name}}(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/Test{{$.Name}}On{{.Name}}")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *{{$.Package}}.{{.Name}}Event)
+	mockSocket.{{$.Name}}().On{{.Name}}(func(eventData *{{$.Package}}.{{.Name}}Event) {
+		resultChan <- eventData
+	})
+	mockResult := &{{$.Package}}.{{.Name}}Event{
+{{- range .Samples}}
+		{{.Field}}: {{.Value}},
+{{- end}}
+	}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "{{.Method}}",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if mockResult.Err != result.Err {
+		t.Errorf("Expected '%v', got: '%v'", mockResult, result)
+	}
+{{- with .Check}}
+	if mockResult.{{.Field}} != result.{{.Field}} {
+		t.Errorf("Expected %v, got %v", mockResult.{{.Field}}, result.{{.Field}})
+	}
+{{- end}}
+
+	resultChan = make(chan *{{$.Package}}.{{.Name}}Event)
+	mockSocket.{{$.Name}}().On{{.Name}}(func(eventData *{{$.Package}}.{{.Name}}Event) {
+		resultChan <- eventData
+	})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(` + "`\"error data\"`" + `),
+			Message: "error message",
+		},
+		Method: "{{.Method}}",
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+{{end -}}
+`
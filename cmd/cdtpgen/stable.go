@@ -0,0 +1,135 @@
+package main
+
+/*
+Stable returns a copy of the protocol limited to its stable surface.
+
+Experimental and deprecated domains are marked as omitted, experimental and
+deprecated commands and events are removed, as are optional experimental and
+deprecated properties. Types are kept only if they are still referenced. Types
+from omitted domains that are referenced by the stable surface are duplicated
+into the referencing packages by the generator.
+*/
+func Stable(protocol *Protocol) *Protocol {
+	stable := &Protocol{Version: protocol.Version}
+	for _, domain := range protocol.Domains {
+		copied := &Domain{
+			Domain:       domain.Domain,
+			Description:  domain.Description,
+			Experimental: domain.Experimental,
+			Deprecated:   domain.Deprecated,
+			Dependencies: domain.Dependencies,
+			Omitted:      domain.Experimental || domain.Deprecated,
+		}
+		for _, typ := range domain.Types {
+			copiedType := *typ
+			copiedType.Properties = stableProps(typ.Properties)
+			copied.Types = append(copied.Types, &copiedType)
+		}
+		if !copied.Omitted {
+			for _, command := range domain.Commands {
+				if command.Experimental || command.Deprecated {
+					continue
+				}
+				copiedCommand := *command
+				copiedCommand.Parameters = stableProps(command.Parameters)
+				copiedCommand.Returns = stableProps(command.Returns)
+				copied.Commands = append(copied.Commands, &copiedCommand)
+			}
+			for _, event := range domain.Events {
+				if event.Experimental || event.Deprecated {
+					continue
+				}
+				copiedEvent := *event
+				copiedEvent.Parameters = stableProps(event.Parameters)
+				copied.Events = append(copied.Events, &copiedEvent)
+			}
+		}
+		stable.Domains = append(stable.Domains, copied)
+	}
+
+	used := stableTypes(stable)
+	for _, domain := range stable.Domains {
+		types := []*Type{}
+		for _, typ := range domain.Types {
+			if used[domain.Domain+"."+typ.ID] || (!domain.Omitted && !typ.Experimental && !typ.Deprecated) {
+				types = append(types, typ)
+			}
+		}
+		domain.Types = types
+	}
+	return stable
+}
+
+/*
+stableProps removes optional experimental and deprecated properties. Required
+properties are always kept.
+*/
+func stableProps(props []*Property) []*Property {
+	result := []*Property{}
+	for _, prop := range props {
+		if prop.Optional && (prop.Experimental || prop.Deprecated) {
+			continue
+		}
+		result = append(result, prop)
+	}
+	return result
+}
+
+/*
+stableTypes returns the set of types, keyed by 'Domain.ID', that are referenced
+by the commands, events and stable types of the stable domains.
+*/
+func stableTypes(protocol *Protocol) map[string]bool {
+	used := map[string]bool{}
+	queue := []string{}
+	addProps := func(domain string, props []*Property) {
+		for _, prop := range props {
+			ref := prop.Ref
+			if nil != prop.Items && "" != prop.Items.Ref {
+				ref = prop.Items.Ref
+			}
+			if "" == ref {
+				continue
+			}
+			refDomain, id := splitRef(domain, ref)
+			if key := refDomain + "." + id; !used[key] {
+				used[key] = true
+				queue = append(queue, key)
+			}
+		}
+	}
+
+	for _, domain := range protocol.Domains {
+		if domain.Omitted {
+			continue
+		}
+		for _, command := range domain.Commands {
+			addProps(domain.Domain, command.Parameters)
+			addProps(domain.Domain, command.Returns)
+		}
+		for _, event := range domain.Events {
+			addProps(domain.Domain, event.Parameters)
+		}
+		for _, typ := range domain.Types {
+			if !typ.Experimental && !typ.Deprecated {
+				addProps(domain.Domain, []*Property{{Ref: typ.ID}})
+			}
+		}
+	}
+
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		domainName, id := splitRef("", key)
+		domain := protocol.Domain(domainName)
+		if nil == domain || nil == domain.Type(id) {
+			continue
+		}
+		typ := domain.Type(id)
+		addProps(domainName, typ.Properties)
+		if nil != typ.Items {
+			addProps(domainName, []*Property{{Ref: typ.Items.Ref}})
+		}
+	}
+	return used
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"sort"
+	"text/template"
+)
+
+/*
+writeSurface generates the protocol surface of the specified domains: the
+methods and events their socket protocol wrappers call and handle, and the
+stable part of it without experimental and deprecated domains, methods and
+events.
+*/
+func (gen *Generator) writeSurface(domains []*Domain) {
+	data := map[string][]string{
+		"Events":        {},
+		"Methods":       {},
+		"StableEvents":  {},
+		"StableMethods": {},
+	}
+	for _, domain := range domains {
+		stable := !domain.Experimental && !domain.Deprecated
+		for _, command := range domain.Commands {
+			data["Methods"] = append(data["Methods"], domain.Domain+"."+command.Name)
+			if stable && !command.Experimental && !command.Deprecated {
+				data["StableMethods"] = append(data["StableMethods"], domain.Domain+"."+command.Name)
+			}
+		}
+		for _, event := range domain.Events {
+			data["Events"] = append(data["Events"], domain.Domain+"."+event.Name)
+			if stable && !event.Experimental && !event.Deprecated {
+				data["StableEvents"] = append(data["StableEvents"], domain.Domain+"."+event.Name)
+			}
+		}
+	}
+	for _, names := range data {
+		sort.Strings(names)
+	}
+
+	path := gen.SocketDir + "/socket.protocol_surface.go"
+	buf := &bytes.Buffer{}
+	buf.WriteString(header)
+	if err := surfaceTemplate.Execute(buf, data); nil != err {
+		gen.errorf("%s: %s", path, err)
+		return
+	}
+	gen.addFile(path, buf.Bytes())
+}
+
+var surfaceTemplate = template.Must(template.New("surface").Parse(`package socket
+
+/*
+Surface is the protocol surface of the generated protocol wrappers: the methods
+their commands call and the events they handle. The compatibility check compares
+it with the protocol supported by the browser, see Socket.CheckCompatibility.
+*/
+var Surface = &ProtocolSurface{
+	Events: []string{
+{{- range .Events}}
+		"{{.}}",
+{{- end}}
+	},
+	Methods: []string{
+{{- range .Methods}}
+		"{{.}}",
+{{- end}}
+	},
+}
+
+/*
+StableSurface is the part of Surface that isn't experimental or deprecated.
+Strict compatibility checks require the browser to support it, see
+WithCompatibilityCheck.
+*/
+var StableSurface = &ProtocolSurface{
+	Events: []string{
+{{- range .StableEvents}}
+		"{{.}}",
+{{- end}}
+	},
+	Methods: []string{
+{{- range .StableMethods}}
+		"{{.}}",
+{{- end}}
+	},
+}
+`))
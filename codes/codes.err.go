@@ -0,0 +1,77 @@
+package codes
+
+import (
+	"errors"
+
+	errs "github.com/bdlm/errors"
+	std "github.com/bdlm/std/error"
+)
+
+/*
+Err is a bdlm/errors error stack that also supports the standard library's
+errors.Is, errors.As and errors.Unwrap: it unwraps to the error it wraps, and
+errors.As can extract the underlying *errs.Err.
+*/
+type Err struct {
+	*errs.Err
+	cause error
+}
+
+/*
+New returns an error with caller information for debugging.
+*/
+func New(code std.Code, msg string, data ...interface{}) *Err {
+	return &Err{Err: errs.New(code, msg, data...)}
+}
+
+/*
+Wrap wraps an error into a new stack led by msg. The returned error unwraps to
+err.
+*/
+func Wrap(err error, code std.Code, msg string, data ...interface{}) *Err {
+	stack := err
+	if e, ok := err.(*Err); ok {
+		// Merge the stacks so the wrapped error's trace is kept.
+		stack = e.Err
+	}
+	return &Err{Err: errs.Wrap(stack, code, msg, data...), cause: err}
+}
+
+/*
+With adds err to the stack without changing the leading error. The returned
+error unwraps to err.
+*/
+func (err *Err) With(e error, msg string, data ...interface{}) *Err {
+	return &Err{Err: err.Err.With(e, msg, data...), cause: e}
+}
+
+/*
+Unwrap returns the wrapped error, if any.
+*/
+func (err *Err) Unwrap() error {
+	return err.cause
+}
+
+/*
+As sets target to the underlying error stack if target is a **errs.Err.
+*/
+func (err *Err) As(target interface{}) bool {
+	if stack, ok := target.(**errs.Err); ok {
+		*stack = err.Err
+		return true
+	}
+	return false
+}
+
+/*
+Is returns whether any error in the chain of err carries the specified code.
+*/
+func Is(err error, code std.Code) bool {
+	for nil != err {
+		if coder, ok := err.(interface{ Code() std.Code }); ok && code == coder.Code() {
+			return true
+		}
+		err = errors.Unwrap(err)
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package codes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	errs "github.com/bdlm/errors"
+)
+
+func TestWrap(t *testing.T) {
+	err := Wrap(context.Canceled, SocketCommandCancelled, "command cancelled")
+	err = Wrap(err, SocketWriteFailed, "write failed")
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected errors.Is to find context.Canceled in %v", err)
+	}
+	if SocketWriteFailed != err.Code() {
+		t.Errorf("Expected code %d, got %d", SocketWriteFailed, err.Code())
+	}
+	if "write failed" != err.Error() {
+		t.Errorf("Expected 'write failed', got '%s'", err.Error())
+	}
+	if !Is(err, SocketCommandCancelled) || !Is(err, SocketWriteFailed) {
+		t.Errorf("Expected both codes in the error chain")
+	}
+	if Is(err, SocketPanic) {
+		t.Errorf("Expected %d not to be in the error chain", SocketPanic)
+	}
+
+	var stack *errs.Err
+	if !errors.As(err, &stack) {
+		t.Fatalf("Expected errors.As to find an *errs.Err")
+	}
+	if SocketWriteFailed != stack.Code() || 2 > stack.Len() {
+		t.Errorf("Expected the merged error stack, got %d entries with code %d", stack.Len(), stack.Code())
+	}
+
+	var target *Err
+	if !errors.As(Wrap(err, Unknown, "outer"), &target) || Unknown != target.Code() {
+		t.Errorf("Expected errors.As to find the outer *Err")
+	}
+}
+
+func TestNew(t *testing.T) {
+	err := New(ChromeStartTimeout, "took %d seconds", 10)
+	if "took 10 seconds" != err.Error() {
+		t.Errorf("Expected 'took 10 seconds', got '%s'", err.Error())
+	}
+	if nil != errors.Unwrap(err) {
+		t.Errorf("Expected nil, got %v", errors.Unwrap(err))
+	}
+	if !Is(err, ChromeStartTimeout) {
+		t.Errorf("Expected code %d", ChromeStartTimeout)
+	}
+}
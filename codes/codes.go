@@ -36,6 +36,19 @@ const (
 	ChromeTabNotFound
 	// ChromeVersionQueryFailed - 2008: Chromium version query failed.
 	ChromeVersionQueryFailed
+	// ChromeConnectFailed - 2009: Connecting to a running Chromium instance
+	// failed.
+	ChromeConnectFailed
+	// ChromePipeFailed - 2010: Cannot create the remote debugging pipes.
+	ChromePipeFailed
+	// ChromeBinaryNotFound - 2011: No usable Chromium binary was found.
+	ChromeBinaryNotFound
+	// ChromePortDiscoveryFailed - 2012: Chromium did not report its
+	// debugging port.
+	ChromePortDiscoveryFailed
+	// ChromeUserDataDirFailed - 2013: Cannot create the user data
+	// directory.
+	ChromeUserDataDirFailed
 )
 
 ////////////////////////////////////////////////////////////////////////////
@@ -83,6 +96,30 @@ const (
 	SocketWriteFailed
 	// SocketPanic - 5003: A panic occurred while reading from a websocket.
 	SocketPanic
+	// SocketCommandCancelled - 5009: The command context was done before a
+	// response was received.
+	SocketCommandCancelled
+	// SocketCommandTimeout - 5010: No response was received before the command
+	// timeout expired.
+	SocketCommandTimeout
+	// SocketConnectionLost - 5011: The websocket connection was lost before a
+	// response was received.
+	SocketConnectionLost
+	// SocketReconnectFailed - 5012: The websocket could not be reconnected.
+	SocketReconnectFailed
+	// SocketSessionAttachFailed - 5013: Attaching to a target session failed.
+	SocketSessionAttachFailed
+	// SocketResultInvalid - 5014: A command result could not be unmarshalled.
+	SocketResultInvalid
+	// SocketWaitCancelled - 5015: The wait context was done or the socket was
+	// stopped before a matching event was received.
+	SocketWaitCancelled
+	// SocketProtocolIncompatible - 5016: The browser does not support the
+	// protocol methods and events of the library.
+	SocketProtocolIncompatible
+	// SocketProtocolSchemaFailed - 5017: The browser protocol schema could not
+	// be retrieved.
+	SocketProtocolSchemaFailed
 )
 
 ////////////////////////////////////////////////////////////////////////////
@@ -95,6 +132,12 @@ const (
 	WebsocketNotConnected
 	// WebsocketPanic - 5002: A panic occurred while reading from a websocket.
 	WebsocketPanic
+	// WebsocketTranscriptInvalid - 6003: A websocket transcript could not be
+	// read.
+	WebsocketTranscriptInvalid
+	// WebsocketReplayMismatch - 6004: No recorded command matches a replayed
+	// command.
+	WebsocketReplayMismatch
 )
 
 func init() {
@@ -111,6 +154,11 @@ func init() {
 	errs.Codes[ChromeStartTimeout] = errs.ErrCode{Int: "Chromium took too long to start", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[ChromeTabNotFound] = errs.ErrCode{Int: "Chromium tab not found", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[ChromeVersionQueryFailed] = errs.ErrCode{Int: "Chromium version query failed", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeConnectFailed] = errs.ErrCode{Int: "Connecting to a running Chromium instance failed", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromePipeFailed] = errs.ErrCode{Int: "Cannot create the remote debugging pipes", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeBinaryNotFound] = errs.ErrCode{Int: "No usable Chromium binary was found", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromePortDiscoveryFailed] = errs.ErrCode{Int: "Chromium did not report its debugging port", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeUserDataDirFailed] = errs.ErrCode{Int: "Cannot create the user data directory", Ext: "An unknown error occurred", HTTP: 500}
 
 	errs.Codes[FlagDoesNotExist] = errs.ErrCode{Int: "The specified argument does not exist", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[FlagTypeInvalid] = errs.ErrCode{Int: "Invalid data type for the specified argument", Ext: "An unknown error occurred", HTTP: 500}
@@ -122,8 +170,19 @@ func init() {
 	errs.Codes[SocketCloseFailed] = errs.ErrCode{Int: "A failure occurred while closing a websocket", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[SocketReadFailed] = errs.ErrCode{Int: "A failure occurred while reading from a websocket", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[SocketPanic] = errs.ErrCode{Int: "A panic occurred while reading from a websocket", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketCommandCancelled] = errs.ErrCode{Int: "The command context was done before a response was received", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketCommandTimeout] = errs.ErrCode{Int: "No response was received before the command timeout expired", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketConnectionLost] = errs.ErrCode{Int: "The websocket connection was lost before a response was received", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketReconnectFailed] = errs.ErrCode{Int: "The websocket could not be reconnected", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketSessionAttachFailed] = errs.ErrCode{Int: "Attaching to a target session failed", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketResultInvalid] = errs.ErrCode{Int: "A command result could not be unmarshalled", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketWaitCancelled] = errs.ErrCode{Int: "The wait context was done or the socket was stopped before a matching event was received", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketProtocolIncompatible] = errs.ErrCode{Int: "The browser does not support the protocol methods and events of the library", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketProtocolSchemaFailed] = errs.ErrCode{Int: "The browser protocol schema could not be retrieved", Ext: "An unknown error occurred", HTTP: 500}
 
 	errs.Codes[WebsocketConnectFailed] = errs.ErrCode{Int: "Websocket connection failed", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[WebsocketNotConnected] = errs.ErrCode{Int: "Websocket not connected", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[WebsocketPanic] = errs.ErrCode{Int: "A panic occurred while reading from a websocket", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[WebsocketTranscriptInvalid] = errs.ErrCode{Int: "A websocket transcript could not be read", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[WebsocketReplayMismatch] = errs.ErrCode{Int: "No recorded command matches a replayed command", Ext: "An unknown error occurred", HTTP: 500}
 }
@@ -4,10 +4,12 @@ implementation.
 
 Versions
 
-Versioned packages are available. Curently the only version is `tot` or
-Tip-of-Tree. Stable versions will be made available in the future.
+Versioned packages are available. `tot` implements the Tip-of-Tree protocol and
+`v1_3` implements the stable 1.3 protocol with the same Chromium, Tabber and
+Protocoller API.
 
     import "github.com/mkenney/go-chrome/tot"
+    import chrome "github.com/mkenney/go-chrome/v1_3"
 
 Work in progress
 
@@ -0,0 +1,51 @@
+/*
+Package logger defines the Logger interface used by the Chromium process
+management and the websocket connections, with no-op, redacting and log/slog
+implementations.
+
+Nothing is logged unless a Logger is provided:
+
+	browser := chrome.New(flags, binary, workdir, "", "")
+	browser.SetLogger(logger.NewSlog(slog.Default()))
+*/
+package logger
+
+/*
+Fields holds the structured data of a log entry.
+*/
+type Fields map[string]interface{}
+
+/*
+Logger defines the interface for structured loggers. Implementations must be
+safe for concurrent use.
+*/
+type Logger interface {
+	// Debug logs a verbose diagnostic message.
+	Debug(msg string, fields Fields)
+
+	// Info logs an informational message.
+	Info(msg string, fields Fields)
+
+	// Warn logs a recoverable problem.
+	Warn(msg string, fields Fields)
+
+	// Error logs a failure.
+	Error(msg string, fields Fields)
+}
+
+/*
+Nop returns a Logger that discards all log entries.
+*/
+func Nop() Logger {
+	return nop{}
+}
+
+/*
+nop is a Logger that discards all log entries.
+*/
+type nop struct{}
+
+func (nop) Debug(msg string, fields Fields) {}
+func (nop) Info(msg string, fields Fields)  {}
+func (nop) Warn(msg string, fields Fields)  {}
+func (nop) Error(msg string, fields Fields) {}
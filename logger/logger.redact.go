@@ -0,0 +1,66 @@
+package logger
+
+/*
+Redacted replaces the values of redacted fields.
+*/
+const Redacted = "[REDACTED]"
+
+/*
+PayloadFields are the fields holding protocol command parameters, results,
+errors and raw websocket messages. They are redacted by default because they
+may contain page content, cookies and credentials.
+*/
+var PayloadFields = []string{"data", "error", "params", "result"}
+
+/*
+Redact returns a Logger that replaces the values of the specified fields with
+Redacted before passing log entries to logger.
+*/
+func Redact(logger Logger, fields ...string) Logger {
+	redact := &redact{
+		fields: make(map[string]bool, len(fields)),
+		logger: logger,
+	}
+	for _, field := range fields {
+		redact.fields[field] = true
+	}
+	return redact
+}
+
+/*
+redact is a Logger that redacts fields.
+*/
+type redact struct {
+	fields map[string]bool
+	logger Logger
+}
+
+func (logger *redact) Debug(msg string, fields Fields) {
+	logger.logger.Debug(msg, logger.redact(fields))
+}
+
+func (logger *redact) Info(msg string, fields Fields) {
+	logger.logger.Info(msg, logger.redact(fields))
+}
+
+func (logger *redact) Warn(msg string, fields Fields) {
+	logger.logger.Warn(msg, logger.redact(fields))
+}
+
+func (logger *redact) Error(msg string, fields Fields) {
+	logger.logger.Error(msg, logger.redact(fields))
+}
+
+/*
+redact returns a copy of fields with the redacted values replaced.
+*/
+func (logger *redact) redact(fields Fields) Fields {
+	redacted := make(Fields, len(fields))
+	for key, value := range fields {
+		if logger.fields[key] {
+			value = Redacted
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
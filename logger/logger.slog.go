@@ -0,0 +1,61 @@
+//go:build go1.21
+// +build go1.21
+
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+)
+
+/*
+NewSlog returns a Logger that writes log entries to a log/slog Logger, with the
+fields as attributes sorted by key.
+*/
+func NewSlog(logger *slog.Logger) Logger {
+	return &slogLogger{logger: logger}
+}
+
+/*
+slogLogger is a Logger writing to a log/slog Logger.
+*/
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+func (logger *slogLogger) Debug(msg string, fields Fields) {
+	logger.log(slog.LevelDebug, msg, fields)
+}
+
+func (logger *slogLogger) Info(msg string, fields Fields) {
+	logger.log(slog.LevelInfo, msg, fields)
+}
+
+func (logger *slogLogger) Warn(msg string, fields Fields) {
+	logger.log(slog.LevelWarn, msg, fields)
+}
+
+func (logger *slogLogger) Error(msg string, fields Fields) {
+	logger.log(slog.LevelError, msg, fields)
+}
+
+/*
+log writes a log entry at the specified level.
+*/
+func (logger *slogLogger) log(level slog.Level, msg string, fields Fields) {
+	ctx := context.Background()
+	if !logger.logger.Enabled(ctx, level) {
+		return
+	}
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, slog.Any(key, fields[key]))
+	}
+	logger.logger.LogAttrs(ctx, level, msg, attrs...)
+}
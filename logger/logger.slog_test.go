@@ -0,0 +1,32 @@
+//go:build go1.21
+// +build go1.21
+
+package logger
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSlog(t *testing.T) {
+	out := &bytes.Buffer{}
+	log := NewSlog(slog.New(slog.NewTextHandler(out, &slog.HandlerOptions{Level: slog.LevelInfo})))
+
+	log.Debug("hidden", Fields{"key": "value"})
+	log.Info("connected", Fields{"url": "ws://localhost:9222", "socketID": 1})
+	log.Warn("lost", nil)
+	log.Error("failed", nil)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if 3 != len(lines) {
+		t.Fatalf("Expected 3 lines, got %d:\n%s", len(lines), out.String())
+	}
+	if !strings.Contains(lines[0], "level=INFO msg=connected socketID=1 url=ws://localhost:9222") {
+		t.Errorf("Expected sorted attributes, got '%s'", lines[0])
+	}
+	if !strings.Contains(lines[1], "level=WARN msg=lost") || !strings.Contains(lines[2], "level=ERROR msg=failed") {
+		t.Errorf("Expected WARN and ERROR entries, got:\n%s", out.String())
+	}
+}
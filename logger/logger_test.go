@@ -0,0 +1,67 @@
+package logger
+
+import (
+	"testing"
+)
+
+type entry struct {
+	level  string
+	msg    string
+	fields Fields
+}
+
+type captureLogger struct {
+	entries []entry
+}
+
+func (logger *captureLogger) Debug(msg string, fields Fields) {
+	logger.entries = append(logger.entries, entry{"debug", msg, fields})
+}
+func (logger *captureLogger) Info(msg string, fields Fields) {
+	logger.entries = append(logger.entries, entry{"info", msg, fields})
+}
+func (logger *captureLogger) Warn(msg string, fields Fields) {
+	logger.entries = append(logger.entries, entry{"warn", msg, fields})
+}
+func (logger *captureLogger) Error(msg string, fields Fields) {
+	logger.entries = append(logger.entries, entry{"error", msg, fields})
+}
+
+func TestNop(t *testing.T) {
+	log := Nop()
+	log.Debug("debug", nil)
+	log.Info("info", Fields{"key": "value"})
+	log.Warn("warn", nil)
+	log.Error("error", nil)
+}
+
+func TestRedact(t *testing.T) {
+	capture := &captureLogger{}
+	log := Redact(capture, PayloadFields...)
+
+	fields := Fields{"method": "Page.navigate", "params": `{"url":"https://example.com/?token=secret"}`}
+	log.Debug("sending", fields)
+	log.Info("received", Fields{"result": "{}"})
+	log.Warn("unknown", Fields{"data": "{}"})
+	log.Error("failed", Fields{"error": `code=-32000, data="secret", msg=error`})
+
+	if 4 != len(capture.entries) {
+		t.Fatalf("Expected 4 entries, got %d", len(capture.entries))
+	}
+	first := capture.entries[0]
+	if "debug" != first.level || "sending" != first.msg {
+		t.Errorf("Expected a 'sending' debug entry, got %v", first)
+	}
+	if "Page.navigate" != first.fields["method"] {
+		t.Errorf("Expected the method to be logged, got %v", first.fields["method"])
+	}
+	if Redacted != first.fields["params"] {
+		t.Errorf("Expected the params to be redacted, got %v", first.fields["params"])
+	}
+	if Redacted == fields["params"] {
+		t.Errorf("Expected the original fields to be unchanged")
+	}
+	if Redacted != capture.entries[1].fields["result"] || Redacted != capture.entries[2].fields["data"] || Redacted != capture.entries[3].fields["error"] {
+		t.Errorf("Expected the payload fields to be redacted, got %v", capture.entries)
+	}
+}
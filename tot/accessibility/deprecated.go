@@ -0,0 +1,15 @@
+package accessibility
+
+/*
+PartialAXTreeParams is the former name of GetPartialAXTreeParams.
+
+Deprecated: use GetPartialAXTreeParams.
+*/
+type PartialAXTreeParams = GetPartialAXTreeParams
+
+/*
+PartialAXTreeResult is the former name of GetPartialAXTreeResult.
+
+Deprecated: use GetPartialAXTreeResult.
+*/
+type PartialAXTreeResult = GetPartialAXTreeResult
@@ -0,0 +1,29 @@
+package animation
+
+/*
+CanceledEvent is the former name of AnimationCanceledEvent.
+
+Deprecated: use AnimationCanceledEvent.
+*/
+type CanceledEvent = AnimationCanceledEvent
+
+/*
+CreatedEvent is the former name of AnimationCreatedEvent.
+
+Deprecated: use AnimationCreatedEvent.
+*/
+type CreatedEvent = AnimationCreatedEvent
+
+/*
+Effect is the former name of AnimationEffect.
+
+Deprecated: use AnimationEffect.
+*/
+type Effect = AnimationEffect
+
+/*
+StartedEvent is the former name of AnimationStartedEvent.
+
+Deprecated: use AnimationStartedEvent.
+*/
+type StartedEvent = AnimationStartedEvent
@@ -3,6 +3,10 @@ Package cache provides type definitions for use with the Chrome
 ApplicationCache protocol
 
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/
+
+Deprecated: the ApplicationCache domain was removed from the protocol and has no socket
+protocol methods. Browsers that still implement it can be called with
+Socketer.Call() using these types.
 */
 package cache
 
@@ -0,0 +1,23 @@
+/*
+Package cdptest provides an in-process fake of the Chromium developer tools
+endpoints for end-to-end tests that don't need a browser.
+
+A Server implements the /json/version, /json/list, /json/new and /json/close
+HTTP endpoints and the browser and page websockets. Commands are answered with
+an empty result unless a handler is registered for the method:
+
+	server := cdptest.NewServer()
+	defer server.Close()
+
+	server.Handle("Page.navigate",
+		cdptest.Reply(map[string]string{"frameId": "frame-1"}),
+		cdptest.Emit("Page.loadEventFired", map[string]float64{"timestamp": 1}),
+	)
+
+	browser := chrome.New(
+		&chrome.Flags{"addr": server.Address(), "port": server.Port()},
+		"", "", "", "",
+	)
+	tab, err := browser.NewTab("https://example.com/")
+*/
+package cdptest
@@ -0,0 +1,162 @@
+package cdptest
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+/*
+Handler handles a command received by the Server. Handlers are run in the
+order they were registered and may reply to the command and emit events.
+*/
+type Handler func(call *Call)
+
+/*
+Error is a protocol error returned in reply to a command.
+*/
+type Error struct {
+	Code    int             `json:"code"`
+	Data    json.RawMessage `json:"data,omitempty"`
+	Message string          `json:"message"`
+}
+
+/*
+Call is a command received by the Server.
+*/
+type Call struct {
+	// ID is the command ID.
+	ID int `json:"id"`
+
+	// Method is the name of the protocol method.
+	Method string `json:"method"`
+
+	// Params holds the command parameters.
+	Params json.RawMessage `json:"params,omitempty"`
+
+	// SessionID is the target session of a command sent over the browser
+	// connection.
+	SessionID string `json:"sessionId,omitempty"`
+
+	// TargetID is the ID of the page the command was sent to. It is empty for
+	// commands sent to the browser connection.
+	TargetID string `json:"-"`
+
+	conn    *conn
+	mux     sync.Mutex
+	replied bool
+}
+
+/*
+Decode unmarshalls the command parameters into v.
+*/
+func (call *Call) Decode(v interface{}) error {
+	if 0 == len(call.Params) {
+		return nil
+	}
+	return json.Unmarshal(call.Params, v)
+}
+
+/*
+Emit sends an event to the connection the command was received on, in the
+command's session.
+*/
+func (call *Call) Emit(method string, params interface{}) error {
+	return call.conn.send(&message{
+		Method:    method,
+		Params:    marshal(params),
+		SessionID: call.SessionID,
+	})
+}
+
+/*
+Fail replies to the command with a protocol error. Only the first reply is
+sent.
+*/
+func (call *Call) Fail(code int, msg string) error {
+	return call.reply(&message{
+		Error: &Error{Code: code, Message: msg},
+	})
+}
+
+/*
+Reply replies to the command with a result. Only the first reply is sent.
+*/
+func (call *Call) Reply(result interface{}) error {
+	return call.reply(&message{
+		Result: marshal(result),
+	})
+}
+
+/*
+Replied returns whether a reply has been sent.
+*/
+func (call *Call) Replied() bool {
+	call.mux.Lock()
+	defer call.mux.Unlock()
+	return call.replied
+}
+
+/*
+reply sends the first reply to the command.
+*/
+func (call *Call) reply(msg *message) error {
+	call.mux.Lock()
+	defer call.mux.Unlock()
+	if call.replied {
+		return nil
+	}
+	call.replied = true
+	msg.ID = call.ID
+	msg.SessionID = call.SessionID
+	return call.conn.send(msg)
+}
+
+/*
+Reply returns a Handler that replies to commands with result.
+*/
+func Reply(result interface{}) Handler {
+	return func(call *Call) {
+		call.Reply(result)
+	}
+}
+
+/*
+Fail returns a Handler that replies to commands with a protocol error.
+*/
+func Fail(code int, msg string) Handler {
+	return func(call *Call) {
+		call.Fail(code, msg)
+	}
+}
+
+/*
+Emit returns a Handler that emits an event. Commands that haven't been replied
+to are replied to with an empty result first, so the event follows the reply.
+*/
+func Emit(method string, params interface{}) Handler {
+	return func(call *Call) {
+		call.Reply(struct{}{})
+		call.Emit(method, params)
+	}
+}
+
+/*
+marshal returns the JSON encoding of v. Raw JSON strings and byte slices are
+used as is.
+*/
+func marshal(v interface{}) json.RawMessage {
+	switch value := v.(type) {
+	case nil:
+		return json.RawMessage(`{}`)
+	case json.RawMessage:
+		return value
+	case []byte:
+		return json.RawMessage(value)
+	case string:
+		if json.Valid([]byte(value)) {
+			return json.RawMessage(value)
+		}
+	}
+	data, _ := json.Marshal(v)
+	return data
+}
@@ -0,0 +1,359 @@
+package cdptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+/*
+NewServer starts and returns a Server. The caller should call Close when
+finished, to shut it down.
+*/
+func NewServer() *Server {
+	server := &Server{
+		conns:    make(map[*conn]bool),
+		handlers: make(map[string][]Handler),
+		mux:      &sync.Mutex{},
+		upgrader: &websocket.Upgrader{},
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+/*
+Server is a fake of the Chromium developer tools HTTP endpoints and websockets.
+*/
+type Server struct {
+	*httptest.Server
+
+	calls    []*Call
+	conns    map[*conn]bool
+	handlers map[string][]Handler
+	lastID   int
+	mux      *sync.Mutex
+	targets  []*Target
+	upgrader *websocket.Upgrader
+}
+
+/*
+Target is a page target served by the Server, in the format returned by
+/json/list.
+*/
+type Target struct {
+	Description          string `json:"description"`
+	DevtoolsFrontendURL  string `json:"devtoolsFrontendUrl"`
+	ID                   string `json:"id"`
+	Title                string `json:"title"`
+	Type                 string `json:"type"`
+	URL                  string `json:"url"`
+	WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+}
+
+/*
+message is a websocket message sent by the Server.
+*/
+type message struct {
+	Error     *Error          `json:"error,omitempty"`
+	ID        int             `json:"id,omitempty"`
+	Method    string          `json:"method,omitempty"`
+	Params    json.RawMessage `json:"params,omitempty"`
+	Result    json.RawMessage `json:"result,omitempty"`
+	SessionID string          `json:"sessionId,omitempty"`
+}
+
+/*
+conn is a websocket connection to the Server.
+*/
+type conn struct {
+	targetID string
+	ws       *websocket.Conn
+	writeMux sync.Mutex
+}
+
+/*
+send writes a message to the connection.
+*/
+func (conn *conn) send(msg *message) error {
+	conn.writeMux.Lock()
+	defer conn.writeMux.Unlock()
+	return conn.ws.WriteJSON(msg)
+}
+
+/*
+Address returns the host the Server listens on.
+*/
+func (server *Server) Address() string {
+	host, _, _ := net.SplitHostPort(server.Listener.Addr().String())
+	return host
+}
+
+/*
+Port returns the port the Server listens on.
+*/
+func (server *Server) Port() int {
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	value, _ := strconv.Atoi(port)
+	return value
+}
+
+/*
+BrowserURL returns the browser websocket URL reported by /json/version.
+*/
+func (server *Server) BrowserURL() string {
+	return fmt.Sprintf("ws://%s/devtools/browser/cdptest", server.Listener.Addr().String())
+}
+
+/*
+Handle registers handlers for a protocol method. Registering a method again
+replaces its handlers. Commands that aren't replied to by a handler are replied
+to with an empty result.
+*/
+func (server *Server) Handle(method string, handlers ...Handler) {
+	server.mux.Lock()
+	defer server.mux.Unlock()
+	server.handlers[method] = handlers
+}
+
+/*
+Calls returns the commands received so far, optionally limited to the specified
+methods.
+*/
+func (server *Server) Calls(methods ...string) []*Call {
+	server.mux.Lock()
+	defer server.mux.Unlock()
+	calls := []*Call{}
+	for _, call := range server.calls {
+		if 0 == len(methods) || contains(methods, call.Method) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+/*
+Emit broadcasts an event to every open websocket connection, the browser
+connection and those of all targets. Use EmitTo to send an event to a single
+target.
+*/
+func (server *Server) Emit(method string, params interface{}) {
+	server.emit(func(*conn) bool { return true }, method, params)
+}
+
+/*
+EmitTo sends an event to the websocket connections of a target. An empty
+targetID sends the event to the browser connections.
+*/
+func (server *Server) EmitTo(targetID string, method string, params interface{}) {
+	server.emit(func(conn *conn) bool { return conn.targetID == targetID }, method, params)
+}
+
+/*
+emit sends an event to the open websocket connections matching filter.
+*/
+func (server *Server) emit(filter func(*conn) bool, method string, params interface{}) {
+	server.mux.Lock()
+	conns := make([]*conn, 0, len(server.conns))
+	for conn := range server.conns {
+		if filter(conn) {
+			conns = append(conns, conn)
+		}
+	}
+	server.mux.Unlock()
+
+	for _, conn := range conns {
+		conn.send(&message{Method: method, Params: marshal(params)})
+	}
+}
+
+/*
+AddTarget adds a page target, as if it had been opened by the user, and returns
+it.
+*/
+func (server *Server) AddTarget(targetURL string) *Target {
+	server.mux.Lock()
+	defer server.mux.Unlock()
+	server.lastID++
+	target := &Target{
+		ID:   fmt.Sprintf("target-%d", server.lastID),
+		Type: "page",
+		URL:  targetURL,
+	}
+	target.WebSocketDebuggerURL = fmt.Sprintf("ws://%s/devtools/page/%s", server.Listener.Addr().String(), target.ID)
+	server.targets = append(server.targets, target)
+	return target
+}
+
+/*
+Targets returns the open page targets.
+*/
+func (server *Server) Targets() []*Target {
+	server.mux.Lock()
+	defer server.mux.Unlock()
+	return append([]*Target{}, server.targets...)
+}
+
+/*
+Close shuts down the Server and closes all websocket connections.
+*/
+func (server *Server) Close() {
+	server.mux.Lock()
+	for conn := range server.conns {
+		conn.ws.Close()
+	}
+	server.mux.Unlock()
+	server.Server.Close()
+}
+
+/*
+serveHTTP routes the developer tools endpoints.
+*/
+func (server *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case "/json/version" == r.URL.Path:
+		writeJSON(w, map[string]string{
+			"Browser":              "HeadlessChrome/cdptest",
+			"Protocol-Version":     "1.3",
+			"User-Agent":           "cdptest",
+			"webSocketDebuggerUrl": server.BrowserURL(),
+		})
+
+	case "/json" == r.URL.Path || "/json/list" == r.URL.Path:
+		writeJSON(w, server.Targets())
+
+	case "/json/new" == r.URL.Path:
+		targetURL, err := url.QueryUnescape(r.URL.RawQuery)
+		if nil != err || "" == targetURL {
+			targetURL = "about:blank"
+		}
+		writeJSON(w, server.AddTarget(targetURL))
+
+	case strings.HasPrefix(r.URL.Path, "/json/close/"):
+		if server.closeTarget(strings.TrimPrefix(r.URL.Path, "/json/close/")) {
+			fmt.Fprint(w, "Target is closing")
+		} else {
+			http.Error(w, "No such target id", http.StatusNotFound)
+		}
+
+	case "/devtools/browser/cdptest" == r.URL.Path:
+		server.serveWebsocket(w, r, "")
+
+	case strings.HasPrefix(r.URL.Path, "/devtools/page/"):
+		targetID := strings.TrimPrefix(r.URL.Path, "/devtools/page/")
+		if nil == server.target(targetID) {
+			http.NotFound(w, r)
+			return
+		}
+		server.serveWebsocket(w, r, targetID)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+/*
+serveWebsocket reads and dispatches the commands sent to a websocket connection.
+*/
+func (server *Server) serveWebsocket(w http.ResponseWriter, r *http.Request, targetID string) {
+	ws, err := server.upgrader.Upgrade(w, r, nil)
+	if nil != err {
+		return
+	}
+	conn := &conn{targetID: targetID, ws: ws}
+	server.mux.Lock()
+	server.conns[conn] = true
+	server.mux.Unlock()
+
+	defer func() {
+		server.mux.Lock()
+		delete(server.conns, conn)
+		server.mux.Unlock()
+		ws.Close()
+	}()
+
+	for {
+		call := &Call{conn: conn, TargetID: targetID}
+		if err := ws.ReadJSON(call); nil != err {
+			return
+		}
+		server.dispatch(call)
+	}
+}
+
+/*
+dispatch runs the handlers registered for a command and replies with an empty
+result if none of them did.
+*/
+func (server *Server) dispatch(call *Call) {
+	server.mux.Lock()
+	server.calls = append(server.calls, call)
+	handlers := server.handlers[call.Method]
+	server.mux.Unlock()
+
+	for _, handler := range handlers {
+		handler(call)
+	}
+	call.Reply(struct{}{})
+}
+
+/*
+closeTarget removes a target and closes its websocket connections.
+*/
+func (server *Server) closeTarget(targetID string) bool {
+	server.mux.Lock()
+	defer server.mux.Unlock()
+	for k, target := range server.targets {
+		if target.ID == targetID {
+			server.targets = append(server.targets[:k], server.targets[k+1:]...)
+			for conn := range server.conns {
+				if conn.targetID == targetID {
+					conn.ws.Close()
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+/*
+target returns the target with the specified ID.
+*/
+func (server *Server) target(targetID string) *Target {
+	server.mux.Lock()
+	defer server.mux.Unlock()
+	for _, target := range server.targets {
+		if target.ID == targetID {
+			return target
+		}
+	}
+	return nil
+}
+
+/*
+contains returns whether list contains value.
+*/
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+writeJSON writes v to the response as JSON.
+*/
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,118 @@
+package cdptest_test
+
+import (
+	"testing"
+	"time"
+
+	chrome "github.com/mkenney/go-chrome/tot"
+	"github.com/mkenney/go-chrome/tot/cdptest"
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+func TestServer(t *testing.T) {
+	server := cdptest.NewServer()
+	defer server.Close()
+
+	server.Handle("Page.navigate",
+		cdptest.Reply(`{"frameId":"frame-1"}`),
+		cdptest.Emit("Page.loadEventFired", map[string]float64{"timestamp": 1}),
+	)
+	server.Handle("Page.reload", cdptest.Fail(-32000, "Not attached to an active page"))
+
+	browser := chrome.New(
+		&chrome.Flags{"addr": server.Address(), "port": server.Port()},
+		"", "", "", "",
+	)
+
+	version, err := browser.Version()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if server.BrowserURL() != version.WebSocketDebuggerURL {
+		t.Errorf("Expected '%s', received '%s'", server.BrowserURL(), version.WebSocketDebuggerURL)
+	}
+
+	tab, err := browser.NewTab("https://example.com/")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 1 != len(server.Targets()) || "https://example.com/" != server.Targets()[0].URL {
+		t.Fatalf("Expected a target for 'https://example.com/', received %v", server.Targets())
+	}
+
+	loaded := make(chan *page.LoadEventFiredEvent, 1)
+	tab.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		loaded <- event
+	})
+	result := <-tab.Page().Navigate(&page.NavigateParams{URL: "https://example.com/next"})
+	if nil != result.Err {
+		t.Fatalf("Expected nil, received error: %v", result.Err)
+	}
+	if "frame-1" != result.FrameID {
+		t.Errorf("Expected 'frame-1', received '%s'", result.FrameID)
+	}
+	select {
+	case event := <-loaded:
+		if 1 != event.Timestamp {
+			t.Errorf("Expected timestamp 1, received %v", event.Timestamp)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected a Page.loadEventFired event")
+	}
+
+	// EmitTo only reaches the connections of the target.
+	other, err := browser.NewTab("https://example.com/other")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	otherLoaded := make(chan *page.LoadEventFiredEvent, 1)
+	other.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		otherLoaded <- event
+	})
+	if enable := <-other.Page().Enable(nil); nil != enable.Err {
+		t.Fatalf("Expected nil, received error: %v", enable.Err)
+	}
+	server.EmitTo(tab.Data().ID, "Page.loadEventFired", map[string]float64{"timestamp": 2})
+	select {
+	case event := <-loaded:
+		if 2 != event.Timestamp {
+			t.Errorf("Expected timestamp 2, received %v", event.Timestamp)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected a Page.loadEventFired event")
+	}
+	select {
+	case event := <-otherLoaded:
+		t.Errorf("Expected no event for the other target, received %v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if _, err := other.Close(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+
+	calls := server.Calls("Page.navigate")
+	if 1 != len(calls) {
+		t.Fatalf("Expected 1 Page.navigate call, received %d", len(calls))
+	}
+	params := &page.NavigateParams{}
+	if err := calls[0].Decode(params); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if "https://example.com/next" != params.URL || tab.Data().ID != calls[0].TargetID {
+		t.Errorf("Expected navigation of %s to 'https://example.com/next', received %s", tab.Data().ID, calls[0].Params)
+	}
+
+	if reload := <-tab.Page().Reload(&page.ReloadParams{}); nil == reload.Err {
+		t.Errorf("Expected error, received nil")
+	}
+	if enable := <-tab.Page().Enable(nil); nil != enable.Err {
+		t.Errorf("Expected nil, received error: %v", enable.Err)
+	}
+
+	if _, err := tab.Close(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if 0 != len(server.Targets()) {
+		t.Errorf("Expected 0 targets, received %d", len(server.Targets()))
+	}
+}
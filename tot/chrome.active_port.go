@@ -0,0 +1,171 @@
+package chrome
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/logger"
+)
+
+/*
+activePortFile is the file in the user data directory Chromium writes the
+debugging port and browser websocket path to.
+*/
+const activePortFile = "DevToolsActivePort"
+
+/*
+activePortPollInterval is how often the DevToolsActivePort file is checked
+while waiting for Chromium to start listening.
+*/
+var activePortPollInterval = 50 * time.Millisecond
+
+/*
+devToolsListening matches the line Chromium writes to STDERR once the
+developer tools endpoints are listening.
+*/
+var devToolsListening = regexp.MustCompile(`DevTools listening on (ws://\S+)`)
+
+/*
+ephemeral returns whether Chromium picks its own debugging port, i.e. the
+remote-debugging-port flag is 0.
+*/
+func (chrome *Chrome) ephemeral() bool {
+	return !chrome.piped() && 0 == chrome.DebuggingPort()
+}
+
+/*
+watchSTDERR returns a pipe to use as the STDERR of the Chromium process. Its
+output is copied to out and the browser websocket URL of the first "DevTools
+listening on" line is sent to the returned channel. The pipe is drained until
+Chromium closes it so Chromium never blocks writing to STDERR. The channel is
+closed if Chromium exits without reporting the URL.
+*/
+func watchSTDERR(out *os.File) (*os.File, <-chan string, error) {
+	reader, writer, err := os.Pipe()
+	if nil != err {
+		return nil, nil, err
+	}
+
+	listening := make(chan string, 1)
+	go func() {
+		defer reader.Close()
+		// A bufio.Reader has no line length limit, unlike a bufio.Scanner.
+		buffered := bufio.NewReader(reader)
+		for {
+			line, err := buffered.ReadString('\n')
+			out.WriteString(line)
+			if match := devToolsListening.FindStringSubmatch(line); nil != match {
+				listening <- match[1]
+				if _, err := io.Copy(out, buffered); nil != err {
+					io.Copy(ioutil.Discard, buffered)
+				}
+				return
+			}
+			if nil != err {
+				close(listening)
+				return
+			}
+		}
+	}()
+	return writer, listening, nil
+}
+
+/*
+readActivePort reads the debugging port and browser websocket path from the
+DevToolsActivePort file in a user data directory.
+*/
+func readActivePort(dir string) (int, string, error) {
+	content, err := ioutil.ReadFile(filepath.Join(dir, activePortFile))
+	if nil != err {
+		return 0, "", err
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if 2 != len(lines) {
+		return 0, "", fmt.Errorf("invalid %s file", activePortFile)
+	}
+	port, err := strconv.Atoi(strings.TrimSpace(lines[0]))
+	if nil != err || port <= 0 {
+		return 0, "", fmt.Errorf("invalid port '%s' in %s", lines[0], activePortFile)
+	}
+	return port, strings.TrimSpace(lines[1]), nil
+}
+
+/*
+discoverEndpoint waits for Chromium to report the developer tools endpoint it
+listens on, either in the DevToolsActivePort file of its user data directory
+or in a "DevTools listening on" line on STDERR, and points Port, Address and
+BrowserSocket at it.
+
+The addr flag is only replaced by the reported host if it was not set before
+the launch, and never by an unspecified address such as 0.0.0.0. The wait ends
+as soon as Chromium closes STDERR, e.g. when it crashes at startup.
+*/
+func (chrome *Chrome) discoverEndpoint(
+	ctx context.Context,
+	listening <-chan string,
+	keepAddress bool,
+) error {
+	ticker := time.NewTicker(activePortPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if port, path, err := readActivePort(chrome.UserDataDir()); nil == err {
+			chrome.setEndpoint(&url.URL{
+				Scheme: "ws",
+				Host:   net.JoinHostPort(chrome.Address(), strconv.Itoa(port)),
+				Path:   path,
+			}, true)
+			return nil
+		}
+
+		select {
+		case endpoint, ok := <-listening:
+			if !ok {
+				return codes.New(codes.ChromePortDiscoveryFailed, "chromium exited before reporting its debugging port")
+			}
+			browserURL, err := url.Parse(endpoint)
+			if nil != err {
+				return codes.Wrap(err, codes.ChromePortDiscoveryFailed, fmt.Sprintf("invalid browser websocket URL '%s'", endpoint))
+			}
+			chrome.setEndpoint(browserURL, keepAddress)
+			return nil
+		case <-ctx.Done():
+			return codes.Wrap(ctx.Err(), codes.ChromePortDiscoveryFailed, fmt.Sprintf(
+				"chromium did not report its debugging port in '%s' or STDERR",
+				filepath.Join(chrome.UserDataDir(), activePortFile),
+			))
+		case <-ticker.C:
+		}
+	}
+}
+
+/*
+setEndpoint points Port, Address and BrowserSocket at a browser websocket URL.
+*/
+func (chrome *Chrome) setEndpoint(browserURL *url.URL, keepAddress bool) {
+	host := browserURL.Hostname()
+	if ip := net.ParseIP(host); !keepAddress && (nil == ip || !ip.IsUnspecified()) {
+		chrome.Flags().Set("addr", host)
+	}
+	port, _ := strconv.Atoi(browserURL.Port())
+	chrome.Flags().Set("port", port)
+	browserURL.Host = net.JoinHostPort(chrome.Address(), browserURL.Port())
+	chrome.browserURL = browserURL.String()
+
+	chrome.Logger().Info("Chromium debugging endpoint discovered", logger.Fields{
+		"port": port,
+		"url":  chrome.browserURL,
+	})
+}
@@ -0,0 +1,169 @@
+package chrome
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+newVersionServer returns a server for the /json/version endpoint and its port.
+*/
+func newVersionServer() (*httptest.Server, int) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"browser":"HeadlessChrome/120.0.6099.109","webSocketDebuggerUrl":"ws://%s/devtools/browser/abc"}`, r.Host)
+	}))
+	serverURL, _ := url.Parse(server.URL)
+	port, _ := strconv.Atoi(serverURL.Port())
+	return server, port
+}
+
+/*
+fakeBrowser writes an executable that reports its endpoint the way Chromium
+does with remote-debugging-port=0, either in DevToolsActivePort or on STDERR,
+and then waits to be interrupted.
+*/
+func fakeBrowser(t *testing.T, dir string, report string) string {
+	return writeScript(t, dir, "chrome", `for arg in "$@"; do
+	case "$arg" in --user-data-dir=*) profile="${arg#--user-data-dir=}";; esac
+done
+`+report+`
+exec sleep 30`)
+}
+
+func TestLaunchEphemeralPort(t *testing.T) {
+	server, port := newVersionServer()
+	defer server.Close()
+	dir, _ := ioutil.TempDir("", "TestLaunchEphemeralPort")
+	defer os.RemoveAll(dir)
+	binary := fakeBrowser(t, dir, fmt.Sprintf(`printf '%d\n/devtools/browser/abc\n' > "$profile/DevToolsActivePort"`, port))
+
+	// A stale file of a previous instance is ignored.
+	ioutil.WriteFile(filepath.Join(dir, activePortFile), []byte("1\n/devtools/browser/old\n"), 0600)
+
+	chrome := New(&Flags{
+		"remote-debugging-port": 0,
+		"user-data-dir":         dir,
+	}, binary, filepath.Join(dir, "workdir"), filepath.Join(dir, "stdout.log"), filepath.Join(dir, "stderr.log"))
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	defer chrome.Close()
+
+	if port != chrome.Port() {
+		t.Errorf("Expected port %d, got %d", port, chrome.Port())
+	}
+	if "localhost" != chrome.Address() {
+		t.Errorf("Expected 'localhost', got '%s'", chrome.Address())
+	}
+	expected := fmt.Sprintf("ws://localhost:%d/devtools/browser/abc", port)
+	if expected != chrome.browserURL {
+		t.Errorf("Expected '%s', got '%s'", expected, chrome.browserURL)
+	}
+	if version, err := chrome.Version(); nil != err || "HeadlessChrome/120.0.6099.109" != version.Browser {
+		t.Errorf("Expected the version of the discovered endpoint, got %+v, %v", version, err)
+	}
+}
+
+func TestLaunchEphemeralPortSTDERR(t *testing.T) {
+	server, port := newVersionServer()
+	defer server.Close()
+	dir, _ := ioutil.TempDir("", "TestLaunchEphemeralPortSTDERR")
+	defer os.RemoveAll(dir)
+	binary := fakeBrowser(t, dir, fmt.Sprintf(`echo "DevTools listening on ws://127.0.0.1:%d/devtools/browser/abc" >&2`, port))
+
+	stderr := filepath.Join(dir, "stderr.log")
+	chrome := New(&Flags{
+		"remote-debugging-port": 0,
+		"user-data-dir":         dir,
+	}, binary, filepath.Join(dir, "workdir"), filepath.Join(dir, "stdout.log"), stderr)
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	defer chrome.Close()
+
+	if port != chrome.Port() {
+		t.Errorf("Expected port %d, got %d", port, chrome.Port())
+	}
+	if "127.0.0.1" != chrome.Address() {
+		t.Errorf("Expected '127.0.0.1', got '%s'", chrome.Address())
+	}
+	if _, err := chrome.Version(); nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+
+	// STDERR is still written to the configured file.
+	output, _ := ioutil.ReadFile(stderr)
+	if !strings.Contains(string(output), "DevTools listening on") {
+		t.Errorf("Expected STDERR to be copied to '%s', got '%s'", stderr, output)
+	}
+}
+
+func TestLaunchEphemeralPortSTDERRLongLine(t *testing.T) {
+	server, port := newVersionServer()
+	defer server.Close()
+	dir, _ := ioutil.TempDir("", "TestLaunchEphemeralPortSTDERRLongLine")
+	defer os.RemoveAll(dir)
+	// A line longer than the bufio.Scanner limit precedes the endpoint.
+	binary := fakeBrowser(t, dir, fmt.Sprintf(`head -c 100000 /dev/zero | tr '\0' x >&2
+echo >&2
+echo "DevTools listening on ws://127.0.0.1:%d/devtools/browser/abc" >&2`, port))
+
+	chrome := New(&Flags{
+		"remote-debugging-port": 0,
+		"user-data-dir":         dir,
+	}, binary, filepath.Join(dir, "workdir"), filepath.Join(dir, "stdout.log"), filepath.Join(dir, "stderr.log"))
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	defer chrome.Close()
+	if port != chrome.Port() {
+		t.Errorf("Expected port %d, got %d", port, chrome.Port())
+	}
+}
+
+func TestLaunchEphemeralPortCrash(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestLaunchEphemeralPortCrash")
+	defer os.RemoveAll(dir)
+	binary := writeScript(t, dir, "chrome", "echo 'crashed' >&2; exit 1")
+
+	chrome := New(&Flags{
+		"remote-debugging-port": 0,
+		"user-data-dir":         dir,
+	}, binary, filepath.Join(dir, "workdir"), filepath.Join(dir, "stdout.log"), filepath.Join(dir, "stderr.log"))
+	start := time.Now()
+	if err := chrome.Launch(); !codes.Is(err, codes.ChromeStartTimeout) {
+		t.Errorf("Expected a ChromeStartTimeout error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the launch to fail when Chromium exits, waited %s", elapsed)
+	}
+}
+
+func TestReadActivePort(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestReadActivePort")
+	defer os.RemoveAll(dir)
+
+	if _, _, err := readActivePort(dir); nil == err {
+		t.Errorf("Expected an error for a missing file")
+	}
+	ioutil.WriteFile(filepath.Join(dir, activePortFile), []byte("9333\n"), 0600)
+	if _, _, err := readActivePort(dir); nil == err {
+		t.Errorf("Expected an error for an incomplete file")
+	}
+	ioutil.WriteFile(filepath.Join(dir, activePortFile), []byte("9333\n/devtools/browser/abc\n"), 0600)
+	port, path, err := readActivePort(dir)
+	if nil != err || 9333 != port || "/devtools/browser/abc" != path {
+		t.Errorf("Expected 9333 and '/devtools/browser/abc', got %d, '%s', %v", port, path, err)
+	}
+}
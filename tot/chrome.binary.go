@@ -0,0 +1,133 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+binaryNames are the Chromium executable names searched for in PATH, in order of
+preference.
+*/
+var binaryNames = []string{
+	"google-chrome-stable",
+	"google-chrome",
+	"chromium",
+	"chromium-browser",
+	"headless_shell",
+}
+
+/*
+binaryLocations returns the common Chromium install locations of the current
+platform, in order of preference.
+*/
+var binaryLocations = func() []string {
+	switch runtime.GOOS {
+	case "darwin":
+		return []string{
+			"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
+			"/Applications/Chromium.app/Contents/MacOS/Chromium",
+			"/Applications/Google Chrome Canary.app/Contents/MacOS/Google Chrome Canary",
+		}
+	case "windows":
+		locations := []string{}
+		for _, env := range []string{"ProgramFiles", "ProgramFiles(x86)", "LocalAppData"} {
+			if dir := os.Getenv(env); "" != dir {
+				locations = append(locations, filepath.Join(dir, "Google", "Chrome", "Application", "chrome.exe"))
+			}
+		}
+		return locations
+	}
+	return []string{
+		"/usr/bin/google-chrome-stable",
+		"/usr/bin/google-chrome",
+		"/usr/bin/chromium",
+		"/usr/bin/chromium-browser",
+		"/opt/google/chrome/chrome",
+		"/usr/lib/chromium/chromium",
+		"/usr/lib/chromium-browser/chromium-browser",
+		"/snap/bin/chromium",
+		"/headless-shell/headless-shell",
+	}
+}
+
+/*
+Binary describes a Chromium binary found by FindBinary.
+*/
+type Binary struct {
+	// Path is the path to the binary.
+	Path string
+
+	// Version is the version reported by the binary, e.g. 'Google Chrome
+	// 120.0.6099.109', or the file version of the executable on Windows,
+	// e.g. '120.0.6099.109'.
+	Version string
+}
+
+/*
+FindBinary looks for a usable Chromium binary. If the CHROME_PATH environment
+variable is set it is the only candidate. Otherwise PATH is searched for
+google-chrome-stable, google-chrome, chromium, chromium-browser and
+headless_shell, followed by the common install locations of the platform.
+
+Each candidate is run with '--version' and the first one that reports a version
+is returned. On Windows, where '--version' starts a browser instead, the file
+version of the executable is read. If none does a codes.ChromeBinaryNotFound
+error listing every candidate that was tried is returned.
+*/
+func FindBinary(ctx context.Context) (*Binary, error) {
+	candidates := []string{}
+	tried := []string{}
+	if path := os.Getenv("CHROME_PATH"); "" != path {
+		candidates = append(candidates, path)
+	} else {
+		for _, name := range binaryNames {
+			path, err := exec.LookPath(name)
+			if nil != err {
+				tried = append(tried, fmt.Sprintf("%s: not found in PATH", name))
+				continue
+			}
+			candidates = append(candidates, path)
+		}
+		candidates = append(candidates, binaryLocations()...)
+	}
+
+	probed := map[string]bool{}
+	for _, path := range candidates {
+		if probed[path] {
+			continue
+		}
+		probed[path] = true
+
+		version, err := probeBinary(ctx, path)
+		if nil == err {
+			return &Binary{Path: path, Version: version}, nil
+		}
+		tried = append(tried, fmt.Sprintf("%s: %s", path, err))
+	}
+
+	return nil, codes.New(codes.ChromeBinaryNotFound, fmt.Sprintf(
+		"no Chromium binary found, set CHROME_PATH or install Chrome or Chromium; tried %s",
+		strings.Join(tried, ", "),
+	))
+}
+
+/*
+probeBinary checks a candidate binary and returns the version it reports, see
+binaryVersion.
+*/
+func probeBinary(ctx context.Context, path string) (string, error) {
+	if info, err := os.Stat(path); nil != err {
+		return "", fmt.Errorf("not found")
+	} else if info.IsDir() {
+		return "", fmt.Errorf("is a directory")
+	}
+	return binaryVersion(ctx, path)
+}
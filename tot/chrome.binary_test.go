@@ -0,0 +1,155 @@
+package chrome
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+writeScript writes an executable shell script and returns its path.
+*/
+func writeScript(t *testing.T, dir string, name string, script string) string {
+	if "windows" == runtime.GOOS {
+		t.Skip("fake binaries are shell scripts")
+	}
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0700); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	return path
+}
+
+/*
+fakeBinary writes an executable that reports a version and returns its path.
+*/
+func fakeBinary(t *testing.T, dir string, name string, version string) string {
+	return writeScript(t, dir, name, "echo '"+version+"'")
+}
+
+/*
+withBinarySearch sets CHROME_PATH, PATH and the install locations for the
+duration of a test.
+*/
+func withBinarySearch(t *testing.T, chromePath string, path string, locations []string) func() {
+	oldChromePath, hasChromePath := os.LookupEnv("CHROME_PATH")
+	oldPath := os.Getenv("PATH")
+	oldLocations := binaryLocations
+
+	if "" == chromePath {
+		os.Unsetenv("CHROME_PATH")
+	} else {
+		os.Setenv("CHROME_PATH", chromePath)
+	}
+	os.Setenv("PATH", path)
+	binaryLocations = func() []string { return locations }
+
+	return func() {
+		if hasChromePath {
+			os.Setenv("CHROME_PATH", oldChromePath)
+		} else {
+			os.Unsetenv("CHROME_PATH")
+		}
+		os.Setenv("PATH", oldPath)
+		binaryLocations = oldLocations
+	}
+}
+
+func TestFindBinary(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestFindBinary")
+	defer os.RemoveAll(dir)
+	chromium := fakeBinary(t, dir, "chromium", "Chromium 120.0.6099.109")
+	fakeBinary(t, dir, "headless_shell", "HeadlessChrome 119.0.6045.105")
+	defer withBinarySearch(t, "", dir, nil)()
+
+	binary, err := FindBinary(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if chromium != binary.Path {
+		t.Errorf("Expected '%s', got '%s'", chromium, binary.Path)
+	}
+	if "Chromium 120.0.6099.109" != binary.Version {
+		t.Errorf("Expected 'Chromium 120.0.6099.109', got '%s'", binary.Version)
+	}
+}
+
+func TestFindBinaryLocations(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestFindBinaryLocations")
+	defer os.RemoveAll(dir)
+	chrome := fakeBinary(t, dir, "chrome", "Google Chrome 120.0.6099.109")
+	defer withBinarySearch(t, "", filepath.Join(dir, "empty"), []string{filepath.Join(dir, "missing"), chrome})()
+
+	binary, err := FindBinary(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if chrome != binary.Path {
+		t.Errorf("Expected '%s', got '%s'", chrome, binary.Path)
+	}
+}
+
+func TestFindBinaryChromePath(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestFindBinaryChromePath")
+	defer os.RemoveAll(dir)
+	fakeBinary(t, dir, "google-chrome", "Google Chrome 120.0.6099.109")
+	custom := fakeBinary(t, dir, "custom-chrome", "Chromium 121.0.6167.85")
+	defer withBinarySearch(t, custom, dir, nil)()
+
+	binary, err := FindBinary(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if custom != binary.Path || "Chromium 121.0.6167.85" != binary.Version {
+		t.Errorf("Expected '%s', got %+v", custom, binary)
+	}
+
+	// An unusable CHROME_PATH is not replaced by another binary.
+	missing := filepath.Join(dir, "missing-chrome")
+	os.Setenv("CHROME_PATH", missing)
+	if _, err := FindBinary(context.Background()); !codes.Is(err, codes.ChromeBinaryNotFound) {
+		t.Errorf("Expected a ChromeBinaryNotFound error, got %v", err)
+	} else if !strings.Contains(err.Error(), missing) {
+		t.Errorf("Expected the error to list '%s', got '%s'", missing, err.Error())
+	}
+}
+
+func TestFindBinaryNotFound(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestFindBinaryNotFound")
+	defer os.RemoveAll(dir)
+	broken := writeScript(t, dir, "broken", "exit 1")
+	defer withBinarySearch(t, "", dir, []string{broken, filepath.Join(dir, "missing")})()
+
+	_, err := FindBinary(context.Background())
+	if !codes.Is(err, codes.ChromeBinaryNotFound) {
+		t.Fatalf("Expected a ChromeBinaryNotFound error, got %v", err)
+	}
+	for _, tried := range append(binaryNames, broken, filepath.Join(dir, "missing")) {
+		if !strings.Contains(err.Error(), tried) {
+			t.Errorf("Expected the error to list '%s', got '%s'", tried, err.Error())
+		}
+	}
+
+	// A failed search isn't repeated.
+	searches := 0
+	binaryLocations = func() []string {
+		searches++
+		return []string{broken}
+	}
+	chrome := New(&Flags{}, "", "", "", "")
+	if "" != chrome.Binary() {
+		t.Errorf("Expected empty string, received '%s'", chrome.Binary())
+	}
+	if err := chrome.Launch(); !codes.Is(err, codes.ChromeBinaryNotFound) {
+		t.Errorf("Expected a ChromeBinaryNotFound error, got %v", err)
+	}
+	if 1 != searches {
+		t.Errorf("Expected 1 search, got %d", searches)
+	}
+}
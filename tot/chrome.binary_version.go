@@ -0,0 +1,36 @@
+//go:build !windows
+// +build !windows
+
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+/*
+binaryProbeTimeout is how long a candidate binary may take to report its
+version.
+*/
+var binaryProbeTimeout = 10 * time.Second
+
+/*
+binaryVersion runs a candidate binary with '--version' and returns the version
+it reports.
+*/
+func binaryVersion(ctx context.Context, path string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, binaryProbeTimeout)
+	defer cancel()
+	output, err := exec.CommandContext(ctx, path, "--version").Output()
+	if nil != err {
+		return "", fmt.Errorf("'--version' failed: %s", err)
+	}
+	version := strings.TrimSpace(string(output))
+	if "" == version {
+		return "", fmt.Errorf("'--version' reported no version")
+	}
+	return version, nil
+}
@@ -0,0 +1,78 @@
+//go:build windows
+// +build windows
+
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	versionDLL                 = syscall.NewLazyDLL("version.dll")
+	procGetFileVersionInfoSize = versionDLL.NewProc("GetFileVersionInfoSizeW")
+	procGetFileVersionInfo     = versionDLL.NewProc("GetFileVersionInfoW")
+	procVerQueryValue          = versionDLL.NewProc("VerQueryValueW")
+)
+
+/*
+fixedFileInfo is the VS_FIXEDFILEINFO structure of a version resource.
+*/
+type fixedFileInfo struct {
+	Signature        uint32
+	StrucVersion     uint32
+	FileVersionMS    uint32
+	FileVersionLS    uint32
+	ProductVersionMS uint32
+	ProductVersionLS uint32
+	FileFlagsMask    uint32
+	FileFlags        uint32
+	FileOS           uint32
+	FileType         uint32
+	FileSubtype      uint32
+	FileDateMS       uint32
+	FileDateLS       uint32
+}
+
+/*
+binaryVersion returns the file version of a candidate executable. chrome.exe
+starts a browser instead of printing its version when run with '--version', so
+the version resource is read instead.
+*/
+func binaryVersion(ctx context.Context, path string) (string, error) {
+	name, err := syscall.UTF16PtrFromString(path)
+	if nil != err {
+		return "", fmt.Errorf("invalid path: %s", err)
+	}
+	size, _, err := procGetFileVersionInfoSize.Call(uintptr(unsafe.Pointer(name)), 0)
+	if 0 == size {
+		return "", fmt.Errorf("no file version: %s", err)
+	}
+	data := make([]byte, size)
+	ok, _, err := procGetFileVersionInfo.Call(uintptr(unsafe.Pointer(name)), 0, size, uintptr(unsafe.Pointer(&data[0])))
+	if 0 == ok {
+		return "", fmt.Errorf("could not read the file version: %s", err)
+	}
+
+	root, _ := syscall.UTF16PtrFromString(`\`)
+	var info *fixedFileInfo
+	var length uint32
+	ok, _, err = procVerQueryValue.Call(
+		uintptr(unsafe.Pointer(&data[0])),
+		uintptr(unsafe.Pointer(root)),
+		uintptr(unsafe.Pointer(&info)),
+		uintptr(unsafe.Pointer(&length)),
+	)
+	if 0 == ok || nil == info || 0 == info.FileVersionMS {
+		return "", fmt.Errorf("no file version: %s", err)
+	}
+	version := fmt.Sprintf("%d.%d.%d.%d",
+		info.FileVersionMS>>16, info.FileVersionMS&0xffff,
+		info.FileVersionLS>>16, info.FileVersionLS&0xffff,
+	)
+	runtime.KeepAlive(data)
+	return version, nil
+}
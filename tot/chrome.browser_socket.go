@@ -0,0 +1,59 @@
+package chrome
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+BrowserSocket returns the browser-level websocket connection, creating it on
+first use. Targets attached through it with socket.AttachToTarget share the
+single connection, see socket.Session. The connection is stopped by Close.
+
+Instances launched with the remote-debugging-pipe flag use the debugging pipe as
+the browser connection.
+*/
+func (chrome *Chrome) BrowserSocket(options ...socket.Option) (*socket.Socket, error) {
+	if nil != chrome.browser {
+		return chrome.browser, nil
+	}
+	if nil != chrome.pipe {
+		options = append([]socket.Option{socket.WithWebSocket(chrome.dialPipe)}, options...)
+		chrome.browser = socket.New(pipeURL, chrome.newSocketOptions(options...)...)
+		return chrome.browser, nil
+	}
+
+	browserURL := chrome.browserURL
+	if "" == browserURL {
+		version, err := chrome.Version()
+		if nil != err {
+			return nil, err
+		}
+		browserURL = version.WebSocketDebuggerURL
+	}
+	if "" == browserURL {
+		return nil, codes.New(codes.TabWebsocketURLInvalid, "browser websocket URL not available")
+	}
+	websocketURL, err := url.Parse(browserURL)
+	if nil != err {
+		return nil, codes.Wrap(err, codes.TabWebsocketURLInvalid, fmt.Sprintf("invalid browser websocket URL '%s'", browserURL))
+	}
+	chrome.browser = socket.New(websocketURL, chrome.newSocketOptions(options...)...)
+	return chrome.browser, nil
+}
+
+/*
+newSocketOptions returns the options of a new websocket connection: the
+instance logger, the instance socket options and options, in that order.
+*/
+func (chrome *Chrome) newSocketOptions(options ...socket.Option) []socket.Option {
+	socketOptions := []socket.Option{}
+	if nil != chrome.logger {
+		socketOptions = append(socketOptions, socket.WithLogger(chrome.logger))
+	}
+	socketOptions = append(socketOptions, chrome.socketOptions...)
+	return append(socketOptions, options...)
+}
@@ -0,0 +1,50 @@
+package chrome
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+func TestChromiumBrowserSocket(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&Version{
+			WebSocketDebuggerURL: "ws://" + r.Host + "/devtools/browser/TestChromiumBrowserSocket",
+		})
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	port, _ := strconv.Atoi(serverURL.Port())
+	chrome := New(
+		&Flags{
+			"addr": serverURL.Hostname(),
+			"port": port,
+		},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "/devtools/browser/TestChromiumBrowserSocket" != browser.URL().Path {
+		t.Errorf("Expected the browser websocket URL, received '%s'", browser.URL().String())
+	}
+	if again, _ := chrome.BrowserSocket(); again != browser {
+		t.Errorf("Expected the same browser socket")
+	}
+
+	chrome.Close()
+	select {
+	case <-browser.Done():
+	default:
+		t.Errorf("Expected Close to stop the browser socket")
+	}
+}
@@ -1,6 +1,7 @@
 package chrome
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -8,11 +9,12 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
-	errs "github.com/bdlm/errors"
-	"github.com/bdlm/log"
 	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/logger"
+	"github.com/mkenney/go-chrome/tot/socket"
 )
 
 /*
@@ -41,16 +43,52 @@ type Chrome struct {
 	// flags stores CLI arguments for the Chromium binary.
 	flags ChromiumFlags
 
-	// Optional. binary is the path to the Chromium binary. Defaults to
-	// '/usr/bin/google-chrome'.
+	// Optional. binary is the path to the Chromium binary. Defaults to the
+	// binary found by FindBinary.
 	binary string
 
+	// binaryErr is the error of a failed FindBinary search, so the search
+	// isn't repeated.
+	binaryErr error
+
+	// browser is the browser-level websocket connection.
+	browser *socket.Socket
+
+	// browserURL is the browser websocket URL when it is known without
+	// querying the version endpoint.
+	browserURL string
+
+	// discover is set for connected instances that adopt existing targets.
+	discover bool
+
+	// Optional. logger receives the log entries of the instance and its
+	// websocket connections. Defaults to a no-op logger.
+	logger logger.Logger
+
+	// keepProfile is set to keep the temporary profile directory when the
+	// instance is closed.
+	keepProfile bool
+
+	// pipe is the debugging pipe of an instance launched in pipe mode.
+	pipe *socket.PipeWebSocket
+
+	// profile is the temporary profile directory created by Launch.
+	profile string
+
+	// Optional. profileTemplate is a profile directory copied into the
+	// temporary profile directory.
+	profileTemplate string
+
+	// socketOptions are applied to the websocket connections.
+	socketOptions []socket.Option
+
 	// Optional. port is the port number the developer tools endpoints will
 	// listen on. Defaults to 9222.
 	//port int
 
 	// tabs is a list of the currently open tabs.
-	tabs []*Tab
+	tabs    []*Tab
+	tabsMux sync.Mutex
 
 	// version contains Chromium version information.
 	version *Version
@@ -77,6 +115,10 @@ type Chrome struct {
 
 	// process is a pointer to the os.Process struct containing the process PID.
 	process *os.Process
+
+	// scheme is the scheme of the developer tools HTTP endpoint. Defaults to
+	// 'http', Connect sets it to 'https' for https endpoints.
+	scheme string
 }
 
 /*
@@ -102,34 +144,68 @@ func (chrome *Chrome) Flags() ChromiumFlags {
 /*
 Binary implements Chromium.
 
-Default value is '/usr/bin/google-chrome' for use with the mkenney/chromium-headless
-Docker image.
+Default value is the binary found by FindBinary, or an empty string if none is
+found.
 */
 func (chrome *Chrome) Binary() string {
-	if "" == chrome.binary {
-		chrome.binary = "/usr/bin/google-chrome"
-	}
+	chrome.findBinary()
 	return chrome.binary
 }
 
+/*
+findBinary sets the binary to the one found by FindBinary if no binary is set.
+The search runs once, a failed search returns the same error afterwards.
+*/
+func (chrome *Chrome) findBinary() error {
+	if "" != chrome.binary || nil != chrome.binaryErr {
+		return chrome.binaryErr
+	}
+	binary, err := FindBinary(context.Background())
+	if nil != err {
+		chrome.binaryErr = err
+		return err
+	}
+	chrome.Logger().Debug("found Chromium binary", logger.Fields{
+		"path":    binary.Path,
+		"version": binary.Version,
+	})
+	chrome.binary = binary.Path
+	return nil
+}
+
 /*
 Close implements Chromium.
 */
 func (chrome *Chrome) Close() error {
-	if chrome.process != nil {
+	defer chrome.removeProfile()
+	if nil == chrome.process {
+		// The tabs of a connected browser are left open.
+		for _, tab := range chrome.Tabs() {
+			tab.Socket().Stop()
+		}
+	} else {
 		for _, tab := range chrome.Tabs() {
 			tab.Close()
 		}
+	}
+	if nil != chrome.browser {
+		chrome.browser.Stop()
+		chrome.browser = nil
+	}
+	if nil != chrome.pipe {
+		chrome.pipe.Close()
+	}
+	if chrome.process != nil {
 		if err := chrome.process.Signal(os.Interrupt); err != nil {
-			return errs.Wrap(err, codes.ChromeSigintFailed, "chrome process interrupt failed")
+			return codes.Wrap(err, codes.ChromeSigintFailed, "chrome process interrupt failed")
 		}
 		ps, err := chrome.process.Wait()
 		if err != nil {
-			return errs.Wrap(err, codes.ChromeExitTimeout, "error waiting for process exit, result unknown")
+			return codes.Wrap(err, codes.ChromeExitTimeout, "error waiting for process exit, result unknown")
 		}
-		log.WithFields(log.Fields{
+		chrome.Logger().Info("Chromium exited", logger.Fields{
 			"signal": ps.String(),
-		}).Info("Chromium exited")
+		})
 	}
 	if chrome.stdOUTFile != nil {
 		chrome.stdOUTFile.Close()
@@ -152,6 +228,9 @@ func (chrome *Chrome) DebuggingAddress() string {
 
 /*
 DebuggingPort implements Chromium.
+
+Default value is 9222. If it is 0 Chromium picks a free port when launched,
+see Launch.
 */
 func (chrome *Chrome) DebuggingPort() int {
 	if !chrome.Flags().Has("remote-debugging-port") {
@@ -172,7 +251,17 @@ func (chrome *Chrome) GetTab(tabID string) (Tabber, error) {
 			return tab, nil
 		}
 	}
-	err = errs.New(codes.ChromeTabNotFound, fmt.Sprintf("tab '%s' not found", tabID))
+	if chrome.discover {
+		// The tab may have been opened since the last target event.
+		if err = chrome.syncTabs(context.Background()); nil == err {
+			for _, tab = range chrome.Tabs() {
+				if tab.Data().ID == tabID {
+					return tab, nil
+				}
+			}
+		}
+	}
+	err = codes.New(codes.ChromeTabNotFound, fmt.Sprintf("tab '%s' not found", tabID))
 	return tab, err
 }
 
@@ -186,24 +275,41 @@ they aren't included in the Flags definition:
 	remote-debugging-address = "0.0.0.0"
 	remote-debugging-port = 9222
 	port = 9222
-	user-data-dir = os.TempDir() + chrome.Workdir()
+	chrome.binary = FindBinary()
+	user-data-dir = a new temporary directory in chrome.Workdir()
 	chrome.workdir = "headless-chrome"
 	chrome.output = "/dev/stdout"
+
+The temporary user data directory gives every instance a fresh profile and is
+removed by Close, see SetKeepUserDataDir and SetUserDataTemplate. A directory
+set with the user-data-dir flag is used as is and never removed.
+
+When the remote-debugging-pipe flag is set no debugging port is opened.
+Instead, Chromium reads commands from file descriptor 3 and writes messages to
+file descriptor 4, and BrowserSocket, NewTab and Version use these pipes.
+
+When the remote-debugging-port flag is 0 Chromium picks a free port. The port
+and browser websocket path are read from the DevToolsActivePort file in the
+user data directory or the "DevTools listening on" line Chromium writes to
+STDERR, and Port, Address, Version and BrowserSocket use the actual endpoint.
 */
 func (chrome *Chrome) Launch() error {
 	var err error
 
 	// Default values for required parameters
+	keepAddress := chrome.Flags().Has("addr")
 	chrome.Address()
-	chrome.DebuggingAddress()
-	chrome.DebuggingPort()
+	if !chrome.piped() {
+		chrome.DebuggingAddress()
+		chrome.DebuggingPort()
+	}
 	chrome.Port()
-	if !chrome.Flags().Has("user-data-dir") {
-		chrome.Flags().Set("user-data-dir", os.TempDir())
+	if err = chrome.findBinary(); nil != err {
+		return err
 	}
 
 	if err = os.MkdirAll(chrome.Workdir(), 0700); err != nil {
-		return errs.Wrap(err, codes.ChromeInvalidWorkdir, fmt.Sprintf("cannot create working directory '%s'", chrome.Workdir()))
+		return codes.Wrap(err, codes.ChromeInvalidWorkdir, fmt.Sprintf("cannot create working directory '%s'", chrome.Workdir()))
 	}
 
 	if "" == chrome.STDERR() {
@@ -215,7 +321,7 @@ func (chrome *Chrome) Launch() error {
 			0600,
 		)
 		if err != nil {
-			return errs.Wrap(err, codes.ChromeCannotOpenStderr, fmt.Sprintf("cannot open error output file '%s'", chrome.STDERR()))
+			return codes.Wrap(err, codes.ChromeCannotOpenStderr, fmt.Sprintf("cannot open error output file '%s'", chrome.STDERR()))
 		}
 	}
 
@@ -228,43 +334,111 @@ func (chrome *Chrome) Launch() error {
 			0600,
 		)
 		if err != nil {
-			return errs.Wrap(err, codes.ChromeCannotOpenStdout, fmt.Sprintf("cannot open standard output file '%s'", chrome.STDOUT()))
+			return codes.Wrap(err, codes.ChromeCannotOpenStdout, fmt.Sprintf("cannot open standard output file '%s'", chrome.STDOUT()))
 		}
 	}
 
-	log.WithFields(log.Fields{
+	if err = chrome.createProfile(); nil != err {
+		chrome.stdOUTFile.Close()
+		return err
+	}
+
+	chrome.Logger().Info("Starting process", logger.Fields{
 		"flags": chrome.Flags(),
 		"path":  chrome.Binary(),
-	}).Info("Starting process")
+	})
 	var procAttributes os.ProcAttr
 	procAttributes.Dir = chrome.Workdir()
 	procAttributes.Files = []*os.File{nil, chrome.stdOUTFile, chrome.stdERRFile}
+	var stderr *os.File
+	var listening <-chan string
+	if chrome.ephemeral() {
+		// A stale file would report the port of a previous instance.
+		os.Remove(filepath.Join(chrome.UserDataDir(), activePortFile))
+		if stderr, listening, err = watchSTDERR(chrome.stdERRFile); nil != err {
+			chrome.stdOUTFile.Close()
+			chrome.removeProfile()
+			return codes.Wrap(err, codes.ChromeCannotOpenStderr, "cannot watch the error output")
+		}
+		procAttributes.Files[2] = stderr
+	}
+	var pipes []*os.File
+	if chrome.piped() {
+		if pipes, err = chrome.openPipes(); nil != err {
+			chrome.stdOUTFile.Close()
+			chrome.removeProfile()
+			return err
+		}
+		procAttributes.Files = append(procAttributes.Files, pipes...)
+	}
 	chrome.process, err = os.StartProcess(
 		chrome.Binary(),
 		chrome.Flags().List(),
 		&procAttributes,
 	)
+	// Chromium holds its own copies of its pipe ends.
+	for _, pipe := range pipes {
+		pipe.Close()
+	}
+	if nil != stderr {
+		stderr.Close()
+	}
 	if nil != err {
+		if nil != chrome.pipe {
+			chrome.pipe.Close()
+		}
 		chrome.stdOUTFile.Close()
-		return errs.Wrap(err, codes.ChromeCannotOpenStdout, "error starting chrome")
+		chrome.removeProfile()
+		return codes.Wrap(err, codes.ChromeCannotOpenStdout, "error starting chrome")
 	}
 
 	// Wait up to 10 seconds for Chromium to start
-	for i := 0; i < 10; i++ {
-		time.Sleep(time.Second)
-		if _, err = chrome.Version(); nil == err {
-			break
+	if chrome.piped() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		_, err = chrome.pipeVersion(ctx)
+		cancel()
+	} else if chrome.ephemeral() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err = chrome.discoverEndpoint(ctx, listening, keepAddress); nil == err {
+			_, err = chrome.Version()
+		}
+		cancel()
+	} else {
+		for i := 0; i < 10; i++ {
+			time.Sleep(time.Second)
+			if _, err = chrome.Version(); nil == err {
+				break
+			}
 		}
 	}
 	if err != nil {
-		log.Error("Chromium took too long to start")
+		chrome.Logger().Error("Chromium took too long to start", logger.Fields{"error": err})
 		chrome.Close()
-		return errs.Wrap(err, codes.ChromeStartTimeout, "chromium took too long to start")
+		return codes.Wrap(err, codes.ChromeStartTimeout, "chromium took too long to start")
 	}
 
 	return nil
 }
 
+/*
+Logger returns the logger of the instance, a no-op logger unless SetLogger has
+been called.
+*/
+func (chrome *Chrome) Logger() logger.Logger {
+	if nil == chrome.logger {
+		return logger.Nop()
+	}
+	return chrome.logger
+}
+
+/*
+SetLogger sets the logger of the instance. It is also used by the websocket
+connections opened afterwards, see socket.WithLogger.
+*/
+func (chrome *Chrome) SetLogger(log logger.Logger) {
+	chrome.logger = log
+}
+
 /*
 Port implements Chromium.
 
@@ -285,29 +459,50 @@ func (chrome *Chrome) Query(
 	path string,
 	params url.Values,
 	msg interface{}, // Data receiver
+) (interface{}, error) {
+	return chrome.query(context.Background(), path, params, msg)
+}
+
+/*
+query queries the developer tools HTTP endpoint, see Query. The request is
+abandoned when ctx is done.
+*/
+func (chrome *Chrome) query(
+	ctx context.Context,
+	path string,
+	params url.Values,
+	msg interface{}, // Data receiver
 ) (interface{}, error) {
 	if len(params) > 0 {
 		path += fmt.Sprintf("?%s", params.Encode())
 	}
 
-	uri := fmt.Sprintf("http://%s:%d%s", chrome.Address(), chrome.Port(), path)
-	resp, err := http.Get(uri)
+	scheme := chrome.scheme
+	if "" == scheme {
+		scheme = "http"
+	}
+	uri := fmt.Sprintf("%s://%s:%d%s", scheme, chrome.Address(), chrome.Port(), path)
+	req, err := http.NewRequest(http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, codes.Wrap(err, codes.ChromeQueryFailed, "invalid uri")
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
 	if err != nil {
-		return nil, errs.Wrap(err, codes.ChromeQueryFailed, "get uri failed")
+		return nil, codes.Wrap(err, codes.ChromeQueryFailed, "get uri failed")
 	}
 	defer resp.Body.Close()
 
-	log.WithFields(log.Fields{
+	chrome.Logger().Debug("querying chrome", logger.Fields{
 		"path":   path,
 		"status": resp.Status,
-	}).Debug("querying chrome")
+	})
 	if 200 != resp.StatusCode {
-		return nil, errs.New(codes.ChromeQueryFailed, resp.Status)
+		return nil, codes.New(codes.ChromeQueryFailed, resp.Status)
 	}
 
 	content, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, errs.Wrap(err, codes.ChromeQueryFailed, "read failed")
+		return nil, codes.Wrap(err, codes.ChromeQueryFailed, "read failed")
 	} else if err := json.Unmarshal(content, &msg); err != nil {
 		// it's not JSON so just return it
 		return content, nil
@@ -320,14 +515,14 @@ func (chrome *Chrome) Query(
 RemoveTab implements Chromium.
 */
 func (chrome *Chrome) RemoveTab(tab *Tab) {
-	tabs := make([]*Tab, 0)
+	chrome.tabsMux.Lock()
+	defer chrome.tabsMux.Unlock()
 	for k, t := range chrome.tabs {
 		if t == tab {
 			chrome.tabs = append(chrome.tabs[:k], chrome.tabs[k+1:]...)
 			break
 		}
 	}
-	chrome.tabs = tabs
 }
 
 /*
@@ -348,20 +543,37 @@ func (chrome *Chrome) STDOUT() string {
 Tabs implements Chromium.
 */
 func (chrome *Chrome) Tabs() []*Tab {
-	return chrome.tabs
+	chrome.tabsMux.Lock()
+	defer chrome.tabsMux.Unlock()
+	if nil == chrome.tabs {
+		return nil
+	}
+	return append([]*Tab{}, chrome.tabs...)
 }
 
 /*
 Version implements Chromium.
 */
 func (chrome *Chrome) Version() (*Version, error) {
+	return chrome.queryVersion(context.Background())
+}
+
+/*
+queryVersion returns the version information, querying it once, see Version.
+The query is abandoned when ctx is done.
+*/
+func (chrome *Chrome) queryVersion(ctx context.Context) (*Version, error) {
+	if nil == chrome.version && nil != chrome.pipe {
+		return chrome.pipeVersion(ctx)
+	}
 	if nil == chrome.version {
-		if _, err := chrome.Query(
+		if _, err := chrome.query(
+			ctx,
 			"/json/version",
 			url.Values{},
 			&chrome.version,
 		); err != nil {
-			return nil, errs.Wrap(err, codes.ChromeVersionQueryFailed, "version query failed")
+			return nil, codes.Wrap(err, codes.ChromeVersionQueryFailed, "version query failed")
 		}
 	}
 	return chrome.version, nil
@@ -5,8 +5,6 @@ import (
 	"sort"
 	"strings"
 
-	errs "github.com/bdlm/errors"
-	"github.com/bdlm/log"
 	"github.com/mkenney/go-chrome/codes"
 )
 
@@ -22,7 +20,7 @@ func (flags Flags) Get(arg string) (interface{}, error) {
 	var values interface{}
 	var err error
 	if !flags.Has(arg) {
-		err = errs.New(codes.FlagDoesNotExist, fmt.Sprintf("The specified argument '%s' does not exist", arg))
+		err = codes.New(codes.FlagDoesNotExist, fmt.Sprintf("The specified argument '%s' does not exist", arg))
 	} else {
 		values = flags[arg]
 	}
@@ -50,10 +48,7 @@ func (flags Flags) List() []string {
 	sort.Strings(orderedFlags)
 
 	for _, arg := range orderedFlags {
-		val, err := flags.Get(arg)
-		if nil != err {
-			log.Error(err)
-		}
+		val, _ := flags.Get(arg)
 		switch val.(type) {
 		case int:
 			arg = fmt.Sprintf("--%s=%d", arg, val.(int))
@@ -85,7 +80,7 @@ func (flags Flags) Set(arg string, value interface{}) (err error) {
 		case string:
 			flags[arg] = value
 		default:
-			return errs.New(codes.FlagTypeInvalid, fmt.Sprintf("Invalid data type '%T' for argument %s: %+v", value, arg, value))
+			return codes.New(codes.FlagTypeInvalid, fmt.Sprintf("Invalid data type '%T' for argument %s: %+v", value, arg, value))
 		}
 	}
 
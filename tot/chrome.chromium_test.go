@@ -1,6 +1,7 @@
 package chrome
 
 import (
+	"io/ioutil"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -8,6 +9,11 @@ import (
 )
 
 func TestChromiumNew(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestChromiumNew")
+	defer os.RemoveAll(dir)
+	binary := fakeBinary(t, dir, "google-chrome", "Google Chrome 120.0.6099.109")
+	defer withBinarySearch(t, "", dir, nil)()
+
 	chrome := New(
 		&Flags{},
 		"", //"path/to/chrome",
@@ -18,8 +24,8 @@ func TestChromiumNew(t *testing.T) {
 	if "localhost" != chrome.Address() {
 		t.Errorf("Expected 'localhost', received '%s'", chrome.Address())
 	}
-	if "/usr/bin/google-chrome" != chrome.Binary() {
-		t.Errorf("Expected '/usr/bin/google-chrome', received '%s'", chrome.Binary())
+	if binary != chrome.Binary() {
+		t.Errorf("Expected '%s', received '%s'", binary, chrome.Binary())
 	}
 	if "0.0.0.0" != chrome.DebuggingAddress() {
 		t.Errorf("Expected '0.0.0.0', received '%s'", chrome.DebuggingAddress())
@@ -0,0 +1,209 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/logger"
+	"github.com/mkenney/go-chrome/tot/socket"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+Connect returns a Chromium instance connected to an already running browser.
+Launch is not required and Close leaves the browser and its tabs running.
+
+The endpoint is either the developer tools HTTP or HTTPS address, e.g.
+'http://localhost:9222', or the browser websocket URL reported by /json/version,
+e.g. 'ws://localhost:9222/devtools/browser/<id>', in which case the HTTP
+endpoint isn't used. The existing page targets are adopted as tabs from
+Target.getTargets and kept in sync through Target.setDiscoverTargets events on
+the browser connection. The options are applied to all websocket connections.
+ctx bounds the queries and commands sent while connecting.
+*/
+func Connect(ctx context.Context, endpoint string, options ...socket.Option) (*Chrome, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if nil != err {
+		return nil, codes.Wrap(err, codes.ChromeConnectFailed, fmt.Sprintf("invalid endpoint '%s'", endpoint))
+	}
+	port := 80
+	if "https" == endpointURL.Scheme || "wss" == endpointURL.Scheme {
+		port = 443
+	}
+	if "" != endpointURL.Port() {
+		port, _ = strconv.Atoi(endpointURL.Port())
+	}
+
+	chrome := New(
+		&Flags{
+			"addr": endpointURL.Hostname(),
+			"port": port,
+		},
+		"",
+		"",
+		"",
+		"",
+	)
+	chrome.discover = true
+	chrome.socketOptions = options
+
+	switch endpointURL.Scheme {
+	case "ws", "wss":
+		chrome.browserURL = endpoint
+	case "http", "https":
+		chrome.scheme = endpointURL.Scheme
+		if _, err := chrome.queryVersion(ctx); nil != err {
+			return nil, codes.Wrap(err, codes.ChromeConnectFailed, fmt.Sprintf("could not connect to '%s'", endpoint))
+		}
+	default:
+		return nil, codes.New(codes.ChromeConnectFailed, fmt.Sprintf("unsupported endpoint scheme '%s'", endpointURL.Scheme))
+	}
+
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		chrome.Close()
+		return nil, codes.Wrap(err, codes.ChromeConnectFailed, fmt.Sprintf("could not connect to '%s'", endpoint))
+	}
+	browser.Target().OnTargetCreated(func(event *target.TargetCreatedEvent) {
+		if nil != event.TargetInfo {
+			chrome.adoptTab(targetData(browser.URL(), event.TargetInfo))
+		}
+	})
+	browser.Target().OnTargetInfoChanged(func(event *target.TargetInfoChangedEvent) {
+		if nil != event.TargetInfo {
+			chrome.updateTab(event.TargetInfo)
+		}
+	})
+	browser.Target().OnTargetDestroyed(func(event *target.TargetDestroyedEvent) {
+		chrome.forgetTab(string(event.TargetID))
+	})
+
+	if err := chrome.syncTabs(ctx); nil != err {
+		chrome.Close()
+		return nil, codes.Wrap(err, codes.ChromeConnectFailed, fmt.Sprintf("could not list the targets of '%s'", endpoint))
+	}
+
+	result := <-browser.Target().SetDiscoverTargetsContext(ctx, &target.SetDiscoverTargetsParams{
+		Discover: true,
+	})
+	if nil != result.Err {
+		chrome.Close()
+		return nil, codes.Wrap(result.Err, codes.ChromeConnectFailed, "target discovery failed")
+	}
+
+	return chrome, nil
+}
+
+/*
+syncTabs adopts the page targets listed by Target.getTargets and removes the
+tabs that no longer exist.
+*/
+func (chrome *Chrome) syncTabs(ctx context.Context) error {
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		return err
+	}
+	result := <-browser.Target().GetTargetsContext(ctx, nil)
+	if nil != result.Err {
+		return result.Err
+	}
+
+	listed := map[string]bool{}
+	for _, info := range result.TargetInfos {
+		listed[string(info.TargetID)] = true
+		chrome.adoptTab(targetData(browser.URL(), info))
+	}
+	for _, tab := range chrome.Tabs() {
+		if !listed[tab.Data().ID] {
+			chrome.forgetTab(tab.Data().ID)
+		}
+	}
+	return nil
+}
+
+/*
+adoptTab adds an existing page target to the list of open tabs and connects to
+its websocket. Known targets and targets that aren't pages are ignored.
+*/
+func (chrome *Chrome) adoptTab(data *TabData) *Tab {
+	if "page" != data.Type {
+		return nil
+	}
+
+	chrome.tabsMux.Lock()
+	defer chrome.tabsMux.Unlock()
+	for _, tab := range chrome.tabs {
+		if tab.Data().ID == data.ID {
+			return tab
+		}
+	}
+
+	websocketURL, err := url.Parse(data.WebSocketDebuggerURL)
+	if nil != err || "" == data.WebSocketDebuggerURL {
+		chrome.Logger().Warn("could not adopt target, invalid websocket URL", logger.Fields{"targetID": data.ID, "url": data.WebSocketDebuggerURL})
+		return nil
+	}
+	targetURL, _ := url.Parse(data.URL)
+
+	tabSocket := socket.New(websocketURL, chrome.newSocketOptions()...)
+	tab := &Tab{
+		chrome:   chrome,
+		data:     data,
+		protocol: tabSocket,
+		socket:   tabSocket,
+		url:      targetURL,
+	}
+	chrome.tabs = append(chrome.tabs, tab)
+
+	chrome.Logger().Debug("adopted target", logger.Fields{"targetID": data.ID, "url": data.URL})
+	return tab
+}
+
+/*
+forgetTab removes a tab whose target no longer exists and stops its socket.
+*/
+func (chrome *Chrome) forgetTab(targetID string) {
+	for _, tab := range chrome.Tabs() {
+		if tab.Data().ID == targetID {
+			chrome.RemoveTab(tab)
+			go tab.Socket().Stop()
+			return
+		}
+	}
+}
+
+/*
+updateTab updates the metadata of the tab for a changed target.
+*/
+func (chrome *Chrome) updateTab(info *target.TargetInfo) {
+	chrome.tabsMux.Lock()
+	defer chrome.tabsMux.Unlock()
+	for _, tab := range chrome.tabs {
+		if tab.Data().ID == string(info.TargetID) {
+			tab.dataMux.Lock()
+			data := *tab.data
+			data.Title = info.Title
+			data.URL = info.URL
+			tab.data = &data
+			tab.dataMux.Unlock()
+			return
+		}
+	}
+}
+
+/*
+targetData returns the tab metadata for a discovered target. The websocket URL
+is derived from the browser websocket URL.
+*/
+func targetData(browserURL *url.URL, info *target.TargetInfo) *TabData {
+	return &TabData{
+		ID:                   string(info.TargetID),
+		Title:                info.Title,
+		Type:                 info.Type,
+		URL:                  info.URL,
+		WebSocketDebuggerURL: fmt.Sprintf("%s://%s/devtools/page/%s", browserURL.Scheme, browserURL.Host, info.TargetID),
+	}
+}
@@ -0,0 +1,272 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+connectWebSocket is a WebSocketer that answers Target.getTargets with its
+targets and every other command with an empty result, and delivers the events
+pushed to it.
+*/
+type connectWebSocket struct {
+	closed    chan struct{}
+	once      sync.Once
+	responses chan *socket.Response
+	targets   []*target.TargetInfo
+}
+
+func newConnectWebSocket() *connectWebSocket {
+	return &connectWebSocket{
+		closed:    make(chan struct{}),
+		responses: make(chan *socket.Response, 10),
+	}
+}
+
+func (conn *connectWebSocket) Close() error {
+	conn.once.Do(func() { close(conn.closed) })
+	return nil
+}
+
+func (conn *connectWebSocket) ReadJSON(v interface{}) error {
+	select {
+	case response := <-conn.responses:
+		data, _ := json.Marshal(response)
+		return json.Unmarshal(data, v)
+	case <-conn.closed:
+		return errors.New("connection closed")
+	}
+}
+
+func (conn *connectWebSocket) WriteJSON(v interface{}) error {
+	if payload, ok := v.(*socket.Payload); ok {
+		result := []byte(`{}`)
+		if "Target.getTargets" == payload.Method {
+			result, _ = json.Marshal(&target.GetTargetsResult{TargetInfos: conn.targets})
+		}
+		conn.responses <- &socket.Response{ID: payload.ID, Result: result}
+	}
+	return nil
+}
+
+func (conn *connectWebSocket) event(method string, params interface{}) {
+	data, _ := json.Marshal(params)
+	conn.responses <- &socket.Response{Method: method, Params: data}
+}
+
+func TestConnect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/json/version":
+			json.NewEncoder(w).Encode(&Version{
+				WebSocketDebuggerURL: "ws://" + r.Host + "/devtools/browser/browser-1",
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	browser := newConnectWebSocket()
+	browser.targets = []*target.TargetInfo{
+		{TargetID: "page-1", Type: "page", URL: "https://example.com/"},
+		{TargetID: "worker-1", Type: "service_worker"},
+	}
+	chrome, err := Connect(context.Background(), server.URL, socket.WithWebSocket(func(socketURL *url.URL) (socket.WebSocketer, error) {
+		if strings.HasPrefix(socketURL.Path, "/devtools/browser/") {
+			return browser, nil
+		}
+		return newConnectWebSocket(), nil
+	}))
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer chrome.Close()
+
+	if 1 != len(chrome.Tabs()) {
+		t.Fatalf("Expected 1 tab, received %d", len(chrome.Tabs()))
+	}
+	if _, err := chrome.GetTab("page-1"); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+
+	browser.event("Target.targetCreated", &target.TargetCreatedEvent{TargetInfo: &target.TargetInfo{
+		TargetID: "page-2",
+		Type:     "page",
+		URL:      "https://example.com/popup",
+	}})
+	waitForTabs(t, chrome, 2)
+	tab, err := chrome.GetTab("page-2")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "/devtools/page/page-2" != tab.Socket().URL().Path {
+		t.Errorf("Expected '/devtools/page/page-2', received '%s'", tab.Socket().URL().Path)
+	}
+
+	browser.event("Target.targetDestroyed", &target.TargetDestroyedEvent{TargetID: "page-1"})
+	waitForTabs(t, chrome, 1)
+}
+
+func TestConnectNewTab(t *testing.T) {
+	var chrome *Chrome
+	browser := newConnectWebSocket()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/json/version":
+			json.NewEncoder(w).Encode(&Version{
+				WebSocketDebuggerURL: "ws://" + r.Host + "/devtools/browser/browser-1",
+			})
+		case "/json/new":
+			// Target discovery adopts the new target before /json/new
+			// returns.
+			browser.event("Target.targetCreated", &target.TargetCreatedEvent{TargetInfo: &target.TargetInfo{
+				TargetID: "page-1",
+				Type:     "page",
+				URL:      "about:blank",
+			}})
+			waitForTabs(t, chrome, 1)
+			json.NewEncoder(w).Encode(&TabData{
+				ID:                   "page-1",
+				Type:                 "page",
+				URL:                  "about:blank",
+				WebSocketDebuggerURL: "ws://" + r.Host + "/devtools/page/page-1",
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	var err error
+	chrome, err = Connect(context.Background(), server.URL, socket.WithWebSocket(func(socketURL *url.URL) (socket.WebSocketer, error) {
+		if strings.HasPrefix(socketURL.Path, "/devtools/browser/") {
+			return browser, nil
+		}
+		return newConnectWebSocket(), nil
+	}))
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer chrome.Close()
+
+	tab, err := chrome.NewTab("")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 1 != len(chrome.Tabs()) {
+		t.Fatalf("Expected 1 tab, received %d", len(chrome.Tabs()))
+	}
+	if tab != chrome.Tabs()[0] {
+		t.Errorf("Expected the adopted tab to be returned")
+	}
+}
+
+func TestConnectWebSocketEndpoint(t *testing.T) {
+	// No HTTP endpoint is listening, the targets are listed over the browser
+	// connection.
+	browser := newConnectWebSocket()
+	browser.targets = []*target.TargetInfo{{TargetID: "page-1", Type: "page", URL: "https://example.com/"}}
+	chrome, err := Connect(context.Background(), "wss://127.0.0.1:1/devtools/browser/browser-1", socket.WithWebSocket(func(socketURL *url.URL) (socket.WebSocketer, error) {
+		if strings.HasPrefix(socketURL.Path, "/devtools/browser/") {
+			return browser, nil
+		}
+		return newConnectWebSocket(), nil
+	}))
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer chrome.Close()
+
+	tab, err := chrome.GetTab("page-1")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "wss://127.0.0.1:1/devtools/page/page-1" != tab.Socket().URL().String() {
+		t.Errorf("Expected 'wss://127.0.0.1:1/devtools/page/page-1', received '%s'", tab.Socket().URL())
+	}
+}
+
+func TestConnectHTTPS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&Version{
+			WebSocketDebuggerURL: "wss://" + r.Host + "/devtools/browser/browser-1",
+		})
+	}))
+	defer server.Close()
+	defaultClient := http.DefaultClient
+	http.DefaultClient = server.Client()
+	defer func() { http.DefaultClient = defaultClient }()
+
+	chrome, err := Connect(context.Background(), server.URL, socket.WithWebSocket(func(socketURL *url.URL) (socket.WebSocketer, error) {
+		return newConnectWebSocket(), nil
+	}))
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer chrome.Close()
+	if "https" != chrome.scheme {
+		t.Errorf("Expected 'https', received '%s'", chrome.scheme)
+	}
+}
+
+func TestConnectContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := Connect(ctx, server.URL); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the version query to be cancelled, waited %s", elapsed)
+	}
+}
+
+func TestUpdateTab(t *testing.T) {
+	chrome := New(&Flags{}, "", "", "", "")
+	tab := &Tab{chrome: chrome, data: &TabData{ID: "page-1", Title: "old"}}
+	chrome.tabs = append(chrome.tabs, tab)
+	data := tab.Data()
+
+	chrome.updateTab(&target.TargetInfo{TargetID: "page-1", Title: "new", URL: "https://example.com/"})
+	if "new" != tab.Data().Title || "https://example.com/" != tab.Data().URL {
+		t.Errorf("Expected the tab data to be updated, received %+v", tab.Data())
+	}
+	if "old" != data.Title {
+		t.Errorf("Expected earlier snapshots to be unchanged, received '%s'", data.Title)
+	}
+}
+
+func TestConnectInvalidEndpoint(t *testing.T) {
+	if _, err := Connect(context.Background(), "ftp://localhost:9222"); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+}
+
+func waitForTabs(t *testing.T, chrome *Chrome, count int) {
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if count == len(chrome.Tabs()) {
+			return
+		}
+	}
+	t.Fatalf("Expected %d tabs, received %d", count, len(chrome.Tabs()))
+}
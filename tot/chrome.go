@@ -7,27 +7,6 @@ https://chromedevtools.github.io/devtools-protocol/tot/ for details.
 */
 package chrome
 
-import (
-	"os"
-
-	"github.com/bdlm/log"
-)
-
-/*
-If a LOG_LEVEL environment variable exists set that value as the log level.
-Useful during development.
-*/
-func init() {
-	levelFlag := os.Getenv("LOG_LEVEL")
-	if "" == levelFlag {
-		levelFlag = "info"
-	}
-	level, err := log.ParseLevel(levelFlag)
-	if nil == err {
-		log.SetLevel(level)
-	}
-}
-
 /*
 Version is a struct representing the Chromium version information.
 */
@@ -0,0 +1,128 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+pipeURL identifies the browser connection of a Chromium instance launched in
+pipe mode.
+*/
+var pipeURL = &url.URL{Scheme: "pipe", Host: "browser"}
+
+/*
+piped returns whether Chromium is launched with the remote-debugging-pipe flag.
+In pipe mode the protocol is spoken over file descriptors 3 and 4 instead of a
+debugging port, the HTTP endpoints aren't available and tabs are attached as
+sessions of the browser connection.
+*/
+func (chrome *Chrome) piped() bool {
+	return chrome.Flags().Has("remote-debugging-pipe")
+}
+
+/*
+openPipes creates the debugging pipes and returns the ends passed to Chromium
+as file descriptors 3 and 4. The ends kept by this process are wrapped in
+chrome.pipe.
+*/
+func (chrome *Chrome) openPipes() ([]*os.File, error) {
+	commandReader, commandWriter, err := os.Pipe()
+	if nil != err {
+		return nil, codes.Wrap(err, codes.ChromePipeFailed, "cannot create the command pipe")
+	}
+	messageReader, messageWriter, err := os.Pipe()
+	if nil != err {
+		commandReader.Close()
+		commandWriter.Close()
+		return nil, codes.Wrap(err, codes.ChromePipeFailed, "cannot create the message pipe")
+	}
+	chrome.pipe = socket.NewPipeWebsocket(messageReader, commandWriter)
+	return []*os.File{commandReader, messageWriter}, nil
+}
+
+/*
+dialPipe returns the debugging pipe as the browser connection.
+*/
+func (chrome *Chrome) dialPipe(socketURL *url.URL) (socket.WebSocketer, error) {
+	if nil == chrome.pipe {
+		return nil, codes.New(codes.WebsocketNotConnected, "debugging pipe not available")
+	}
+	return chrome.pipe, nil
+}
+
+/*
+pipeVersion queries the Chromium version over the browser connection.
+*/
+func (chrome *Chrome) pipeVersion(ctx context.Context) (*Version, error) {
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		return nil, err
+	}
+	result := <-browser.Browser().GetVersionContext(ctx)
+	if nil != result.Err {
+		return nil, codes.Wrap(result.Err, codes.ChromeVersionQueryFailed, "version query failed")
+	}
+	chrome.version = &Version{
+		Browser:         result.Product,
+		ProtocolVersion: result.ProtocolVersion,
+		UserAgent:       result.UserAgent,
+		V8Version:       result.JSVersion,
+	}
+	return chrome.version, nil
+}
+
+/*
+newPipeTab creates a page target and attaches the tab to it as a session of the
+browser connection.
+*/
+func (chrome *Chrome) newPipeTab(tab *Tab) error {
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		return err
+	}
+	result := <-browser.Target().CreateTarget(&target.CreateTargetParams{
+		URL: tab.URL().String(),
+	})
+	if nil != result.Err {
+		return codes.Wrap(result.Err, codes.TabQueryFailed, fmt.Sprintf("could not create a target for '%s'", tab.URL()))
+	}
+	session, err := browser.AttachToTarget(context.Background(), string(result.TargetID))
+	if nil != err {
+		return err
+	}
+
+	tab.data = &TabData{
+		ID:   string(result.TargetID),
+		Type: "page",
+		URL:  tab.URL().String(),
+	}
+	tab.socket = session
+	tab.protocol = session
+	return nil
+}
+
+/*
+closePipeTab closes the target of a tab attached over the browser connection.
+*/
+func (chrome *Chrome) closePipeTab(tab *Tab) (interface{}, error) {
+	tab.Socket().Stop()
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		return nil, err
+	}
+	result := <-browser.Target().CloseTarget(&target.CloseTargetParams{
+		TargetID: target.TargetID(tab.Data().ID),
+	})
+	if nil != result.Err {
+		return nil, codes.Wrap(result.Err, 0, fmt.Sprintf("could not close target '%s'", tab.Data().ID))
+	}
+	chrome.RemoveTab(tab)
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package chrome
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+servePipe answers the commands written to a debugging pipe the way Chromium
+does in pipe mode, with a result for each known method.
+*/
+func servePipe(t *testing.T, results map[string]string) *socket.PipeWebSocket {
+	commandReader, commandWriter := io.Pipe()
+	messageReader, messageWriter := io.Pipe()
+
+	go func() {
+		defer messageWriter.Close()
+		reader := bufio.NewReader(commandReader)
+		for {
+			message, err := reader.ReadBytes(0)
+			if nil != err {
+				return
+			}
+			payload := &socket.Payload{}
+			if err := json.Unmarshal(message[:len(message)-1], payload); nil != err {
+				t.Errorf("Expected nil, received error: %v", err)
+				return
+			}
+			response, _ := json.Marshal(&socket.Response{
+				ID:     payload.ID,
+				Result: []byte(results[payload.Method]),
+			})
+			messageWriter.Write(append(response, 0))
+		}
+	}()
+
+	return socket.NewPipeWebsocket(messageReader, commandWriter)
+}
+
+func TestChromiumPipe(t *testing.T) {
+	chrome := New(&Flags{"remote-debugging-pipe": nil}, "", "", "", "")
+	chrome.pipe = servePipe(t, map[string]string{
+		"Browser.getVersion":    `{"product":"HeadlessChrome/70.0.3538.77","protocolVersion":"1.3"}`,
+		"Target.attachToTarget": `{"sessionId":"session-1"}`,
+		"Target.closeTarget":    `{"success":true}`,
+		"Target.createTarget":   `{"targetId":"page-1"}`,
+	})
+	defer chrome.Close()
+
+	version, err := chrome.Version()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "HeadlessChrome/70.0.3538.77" != version.Browser {
+		t.Errorf("Expected 'HeadlessChrome/70.0.3538.77', received '%s'", version.Browser)
+	}
+
+	tab, err := chrome.NewTab("https://example.com/")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "page-1" != tab.Data().ID {
+		t.Errorf("Expected 'page-1', received '%s'", tab.Data().ID)
+	}
+	session, ok := tab.Socket().(*socket.Session)
+	if !ok {
+		t.Fatalf("Expected a *socket.Session, received %T", tab.Socket())
+	}
+	if "session-1" != session.ID() {
+		t.Errorf("Expected 'session-1', received '%s'", session.ID())
+	}
+
+	if _, err := tab.Close(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if 0 != len(chrome.Tabs()) {
+		t.Errorf("Expected 0 tabs, received %d", len(chrome.Tabs()))
+	}
+}
@@ -0,0 +1,161 @@
+package chrome
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/logger"
+)
+
+/*
+profilePrefix is the name prefix of the temporary user data directories created
+in the working directory.
+*/
+const profilePrefix = "profile-"
+
+/*
+UserDataDir returns the value of the user-data-dir flag. Once the instance is
+launched it is the temporary profile directory created by Launch unless the
+flag was set.
+*/
+func (chrome *Chrome) UserDataDir() string {
+	value, _ := chrome.Flags().Get("user-data-dir")
+	dir, _ := value.(string)
+	return dir
+}
+
+/*
+SetKeepUserDataDir sets whether the temporary profile directory created by
+Launch is kept when the instance is closed, e.g. to inspect it for debugging.
+*/
+func (chrome *Chrome) SetKeepUserDataDir(keep bool) {
+	chrome.keepProfile = keep
+}
+
+/*
+SetUserDataTemplate sets a profile directory whose contents are copied into the
+temporary profile directory created by Launch. It has no effect if the
+user-data-dir flag is set.
+*/
+func (chrome *Chrome) SetUserDataTemplate(dir string) {
+	chrome.profileTemplate = dir
+}
+
+/*
+createProfile creates a temporary profile directory in the working directory,
+seeded from the template if one is set, and points the user-data-dir flag at
+it. The user-data-dir flag is left alone if it was set by the caller.
+*/
+func (chrome *Chrome) createProfile() error {
+	if chrome.Flags().Has("user-data-dir") && chrome.UserDataDir() != chrome.profile {
+		return nil
+	}
+
+	dir, err := ioutil.TempDir(chrome.Workdir(), profilePrefix)
+	if nil != err {
+		return codes.Wrap(err, codes.ChromeUserDataDirFailed, fmt.Sprintf("cannot create a user data directory in '%s'", chrome.Workdir()))
+	}
+	// Chromium resolves a relative user-data-dir against its own working
+	// directory, which is the relative working directory itself.
+	abs, err := filepath.Abs(dir)
+	if nil != err {
+		os.RemoveAll(dir)
+		return codes.Wrap(err, codes.ChromeUserDataDirFailed, fmt.Sprintf("cannot resolve the user data directory '%s'", dir))
+	}
+	dir = abs
+	if "" != chrome.profileTemplate {
+		if err = copyProfile(chrome.profileTemplate, dir); nil != err {
+			os.RemoveAll(dir)
+			return codes.Wrap(err, codes.ChromeUserDataDirFailed, fmt.Sprintf("cannot copy the user data template '%s'", chrome.profileTemplate))
+		}
+	}
+
+	chrome.profile = dir
+	chrome.Flags().Set("user-data-dir", dir)
+	chrome.Logger().Debug("created user data directory", logger.Fields{
+		"path":     dir,
+		"template": chrome.profileTemplate,
+	})
+	return nil
+}
+
+/*
+removeProfile removes the temporary profile directory created by Launch unless
+it is kept.
+*/
+func (chrome *Chrome) removeProfile() {
+	if "" == chrome.profile || chrome.keepProfile {
+		return
+	}
+	if err := os.RemoveAll(chrome.profile); nil != err {
+		chrome.Logger().Warn("could not remove the user data directory", logger.Fields{
+			"error": err,
+			"path":  chrome.profile,
+		})
+		return
+	}
+	chrome.Logger().Debug("removed user data directory", logger.Fields{"path": chrome.profile})
+}
+
+/*
+copyProfile copies the contents of a template profile directory. The lock files
+and DevToolsActivePort file of the instance that used the template are skipped,
+symbolic links are copied as links.
+*/
+func copyProfile(template string, dir string) error {
+	return filepath.Walk(template, func(path string, info os.FileInfo, err error) error {
+		if nil != err {
+			return err
+		}
+		name := info.Name()
+		if activePortFile == name || strings.HasPrefix(name, "Singleton") {
+			return nil
+		}
+		rel, err := filepath.Rel(template, path)
+		if nil != err || "." == rel {
+			return err
+		}
+		target := filepath.Join(dir, rel)
+
+		switch mode := info.Mode(); {
+		case mode.IsDir():
+			return os.Mkdir(target, mode.Perm()|0700)
+		case 0 != mode&os.ModeSymlink:
+			link, err := os.Readlink(path)
+			if nil != err {
+				return err
+			}
+			return os.Symlink(link, target)
+		case mode.IsRegular():
+			return copyFile(path, target, mode.Perm()|0600)
+		}
+		// Sockets and other special files belong to the running instance.
+		return nil
+	})
+}
+
+/*
+copyFile copies a regular file.
+*/
+func copyFile(src string, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if nil != err {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, perm)
+	if nil != err {
+		return err
+	}
+	if _, err = io.Copy(out, in); nil != err {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,173 @@
+package chrome
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+launchProfile launches a fake browser reporting its endpoint in the
+DevToolsActivePort file of its user data directory, pointing at the version
+server listening on port.
+*/
+func launchProfile(t *testing.T, dir string, port int, flags *Flags, setup func(*Chrome)) *Chrome {
+	binary := fakeBrowser(t, dir, fmt.Sprintf(`printf '%d\n/devtools/browser/abc\n' > "$profile/DevToolsActivePort"`, port))
+
+	(*flags)["remote-debugging-port"] = 0
+	chrome := New(flags, binary, filepath.Join(dir, "workdir"), filepath.Join(dir, "stdout.log"), filepath.Join(dir, "stderr.log"))
+	if nil != setup {
+		setup(chrome)
+	}
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	return chrome
+}
+
+func TestLaunchUserDataDir(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestLaunchUserDataDir")
+	defer os.RemoveAll(dir)
+	server, port := newVersionServer()
+	defer server.Close()
+
+	chrome := launchProfile(t, dir, port, &Flags{}, nil)
+	profile := chrome.UserDataDir()
+	if filepath.Dir(profile) != chrome.Workdir() || !strings.HasPrefix(filepath.Base(profile), profilePrefix) {
+		t.Errorf("Expected a profile directory in '%s', got '%s'", chrome.Workdir(), profile)
+	}
+	if _, err := os.Stat(filepath.Join(profile, activePortFile)); nil != err {
+		t.Errorf("Expected the browser to use '%s', got error: %v", profile, err)
+	}
+
+	if err := chrome.Close(); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if _, err := os.Stat(profile); !os.IsNotExist(err) {
+		t.Errorf("Expected '%s' to be removed, got %v", profile, err)
+	}
+
+	// Every launch gets a fresh profile.
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	defer chrome.Close()
+	if profile == chrome.UserDataDir() {
+		t.Errorf("Expected a new profile directory, got '%s' again", profile)
+	}
+}
+
+func TestLaunchUserDataDirKeep(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestLaunchUserDataDirKeep")
+	defer os.RemoveAll(dir)
+	server, port := newVersionServer()
+	defer server.Close()
+
+	chrome := launchProfile(t, dir, port, &Flags{}, func(chrome *Chrome) {
+		chrome.SetKeepUserDataDir(true)
+	})
+	chrome.Close()
+	if _, err := os.Stat(filepath.Join(chrome.UserDataDir(), activePortFile)); nil != err {
+		t.Errorf("Expected '%s' to be kept, got error: %v", chrome.UserDataDir(), err)
+	}
+}
+
+func TestLaunchUserDataDirRelativeWorkdir(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestLaunchUserDataDirRelativeWorkdir")
+	defer os.RemoveAll(dir)
+	server, port := newVersionServer()
+	defer server.Close()
+	cwd, _ := os.Getwd()
+	defer os.Chdir(cwd)
+	os.Chdir(dir)
+
+	binary := fakeBrowser(t, dir, fmt.Sprintf(`printf '%d\n/devtools/browser/abc\n' > "$profile/DevToolsActivePort"`, port))
+	chrome := New(&Flags{"remote-debugging-port": 0}, binary, "workdir", filepath.Join(dir, "stdout.log"), filepath.Join(dir, "stderr.log"))
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	defer chrome.Close()
+
+	profile := chrome.UserDataDir()
+	if !filepath.IsAbs(profile) {
+		t.Errorf("Expected an absolute profile directory, got '%s'", profile)
+	}
+	if port != chrome.Port() {
+		t.Errorf("Expected port %d, got %d", port, chrome.Port())
+	}
+}
+
+func TestLaunchUserDataDirFlag(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestLaunchUserDataDirFlag")
+	defer os.RemoveAll(dir)
+	server, port := newVersionServer()
+	defer server.Close()
+	profile := filepath.Join(dir, "profile")
+	os.Mkdir(profile, 0700)
+
+	chrome := launchProfile(t, dir, port, &Flags{"user-data-dir": profile}, nil)
+	if profile != chrome.UserDataDir() {
+		t.Errorf("Expected '%s', got '%s'", profile, chrome.UserDataDir())
+	}
+	chrome.Close()
+	if _, err := os.Stat(profile); nil != err {
+		t.Errorf("Expected '%s' to be kept, got error: %v", profile, err)
+	}
+}
+
+func TestLaunchUserDataTemplate(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestLaunchUserDataTemplate")
+	defer os.RemoveAll(dir)
+	server, port := newVersionServer()
+	defer server.Close()
+	template := filepath.Join(dir, "template")
+	os.MkdirAll(filepath.Join(template, "Default"), 0700)
+	ioutil.WriteFile(filepath.Join(template, "Default", "Preferences"), []byte(`{"seeded":true}`), 0600)
+	ioutil.WriteFile(filepath.Join(template, activePortFile), []byte("1\n/devtools/browser/old\n"), 0600)
+	os.Symlink("otherhost-123", filepath.Join(template, "SingletonLock"))
+	os.Symlink("Preferences", filepath.Join(template, "Default", "Link"))
+
+	chrome := launchProfile(t, dir, port, &Flags{}, func(chrome *Chrome) {
+		chrome.SetUserDataTemplate(template)
+	})
+	defer chrome.Close()
+	profile := chrome.UserDataDir()
+
+	content, err := ioutil.ReadFile(filepath.Join(profile, "Default", "Preferences"))
+	if nil != err || `{"seeded":true}` != string(content) {
+		t.Errorf("Expected the template preferences, got '%s', %v", content, err)
+	}
+	if link, err := os.Readlink(filepath.Join(profile, "Default", "Link")); nil != err || "Preferences" != link {
+		t.Errorf("Expected the link to be copied, got '%s', %v", link, err)
+	}
+	if _, err := os.Lstat(filepath.Join(profile, "SingletonLock")); !os.IsNotExist(err) {
+		t.Errorf("Expected the template lock not to be copied, got %v", err)
+	}
+	if 0 == chrome.Port() || 1 == chrome.Port() {
+		t.Errorf("Expected the port of the new instance, got %d", chrome.Port())
+	}
+
+	// The template itself is never modified.
+	if _, err := os.Stat(filepath.Join(template, activePortFile)); nil != err {
+		t.Errorf("Expected the template to be left alone, got error: %v", err)
+	}
+}
+
+func TestLaunchUserDataTemplateMissing(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "TestLaunchUserDataTemplateMissing")
+	defer os.RemoveAll(dir)
+
+	chrome := New(&Flags{}, fakeBinary(t, dir, "chrome", "Chromium 120.0.6099.109"), filepath.Join(dir, "workdir"), filepath.Join(dir, "stdout.log"), filepath.Join(dir, "stderr.log"))
+	chrome.SetUserDataTemplate(filepath.Join(dir, "missing"))
+	if err := chrome.Launch(); !codes.Is(err, codes.ChromeUserDataDirFailed) {
+		t.Errorf("Expected a ChromeUserDataDirFailed error, got %v", err)
+	}
+	if entries, _ := ioutil.ReadDir(chrome.Workdir()); 0 != len(entries) {
+		t.Errorf("Expected no profile directory to be left behind, got %d entries", len(entries))
+	}
+}
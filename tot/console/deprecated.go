@@ -0,0 +1,68 @@
+package console
+
+/*
+Message is the former name of ConsoleMessage.
+
+Deprecated: use ConsoleMessage.
+*/
+type Message = ConsoleMessage
+
+/*
+MessageLevelEnum is the former name of LevelEnum.
+
+Deprecated: use LevelEnum.
+*/
+type MessageLevelEnum = LevelEnum
+
+/*
+MessageLevel is the former name of Level.
+
+Deprecated: use Level.
+*/
+var MessageLevel = Level
+
+/*
+The former MessageLevelEnum constants.
+
+Deprecated: use the Level fields, e.g. Level.Log.
+*/
+const (
+	MessageLevelLog     = levelLog
+	MessageLevelWarning = levelWarning
+	MessageLevelError   = levelError
+	MessageLevelDebug   = levelDebug
+	MessageLevelInfo    = levelInfo
+)
+
+/*
+MessageSourceEnum is the former name of SourceEnum.
+
+Deprecated: use SourceEnum.
+*/
+type MessageSourceEnum = SourceEnum
+
+/*
+MessageSource is the former name of Source.
+
+Deprecated: use Source.
+*/
+var MessageSource = Source
+
+/*
+The former MessageSourceEnum constants.
+
+Deprecated: use the Source fields, e.g. Source.XML.
+*/
+const (
+	MessageSourceXML         = sourceXML
+	MessageSourceJavascript  = sourceJavascript
+	MessageSourceNetwork     = sourceNetwork
+	MessageSourceConsoleAPI  = sourceConsoleAPI
+	MessageSourceStorage     = sourceStorage
+	MessageSourceAppcache    = sourceAppcache
+	MessageSourceRendering   = sourceRendering
+	MessageSourceSecurity    = sourceSecurity
+	MessageSourceOther       = sourceOther
+	MessageSourceDeprecation = sourceDeprecation
+	MessageSourceWorker      = sourceWorker
+)
@@ -0,0 +1,80 @@
+package css
+
+/*
+ComputedStyleProperty is the former name of CSSComputedStyleProperty.
+
+Deprecated: use CSSComputedStyleProperty.
+*/
+type ComputedStyleProperty = CSSComputedStyleProperty
+
+/*
+KeyframeRule is the former name of CSSKeyframeRule.
+
+Deprecated: use CSSKeyframeRule.
+*/
+type KeyframeRule = CSSKeyframeRule
+
+/*
+KeyframesRule is the former name of CSSKeyframesRule.
+
+Deprecated: use CSSKeyframesRule.
+*/
+type KeyframesRule = CSSKeyframesRule
+
+/*
+Media is the former name of CSSMedia.
+
+Deprecated: use CSSMedia.
+*/
+type Media = CSSMedia
+
+/*
+Property is the former name of CSSProperty.
+
+Deprecated: use CSSProperty.
+*/
+type Property = CSSProperty
+
+/*
+Rule is the former name of CSSRule.
+
+Deprecated: use CSSRule.
+*/
+type Rule = CSSRule
+
+/*
+Style is the former name of CSSStyle.
+
+Deprecated: use CSSStyle.
+*/
+type Style = CSSStyle
+
+/*
+StyleSheetHeader is the former name of CSSStyleSheetHeader.
+
+Deprecated: use CSSStyleSheetHeader.
+*/
+type StyleSheetHeader = CSSStyleSheetHeader
+
+/*
+The former SourceEnum constants.
+
+Deprecated: use the Source fields, e.g. Source.MediaRule.
+*/
+const (
+	SourceMediaRule   = sourceMediaRule
+	SourceImportRule  = sourceImportRule
+	SourceLinkedSheet = sourceLinkedSheet
+	SourceInlineSheet = sourceInlineSheet
+)
+
+/*
+The former StyleSheetOriginEnum constants.
+
+Deprecated: use the StyleSheetOrigin fields, e.g. StyleSheetOrigin.Injected.
+*/
+const (
+	StyleSheetOriginInjected  = styleSheetOriginInjected
+	StyleSheetOriginUserAgent = styleSheetOriginUserAgent
+	StyleSheetOriginInspector = styleSheetOriginInspector
+)
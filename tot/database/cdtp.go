@@ -2,6 +2,10 @@
 Package database provides type definitions for use with the Chrome Database protocol
 
 https://chromedevtools.github.io/devtools-protocol/tot/Database/
+
+Deprecated: the Database domain was removed from the protocol and has no socket
+protocol methods. Browsers that still implement it can be called with
+Socketer.Call() using these types.
 */
 package database
 
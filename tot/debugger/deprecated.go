@@ -0,0 +1,43 @@
+package debugger
+
+/*
+The former BreakLocationTypeEnum constants.
+
+Deprecated: use the BreakLocationType fields, e.g. BreakLocationType.DebuggerStatement.
+*/
+const (
+	BreakLocationTypeDebuggerStatement = breakLocationTypeDebuggerStatement
+	BreakLocationTypeCall              = breakLocationTypeCall
+	BreakLocationTypeReturn            = breakLocationTypeReturn
+)
+
+/*
+ScopeTypeEnum is the former name of TypeEnum.
+
+Deprecated: use TypeEnum.
+*/
+type ScopeTypeEnum = TypeEnum
+
+/*
+ScopeType is the former name of Type.
+
+Deprecated: use Type.
+*/
+var ScopeType = Type
+
+/*
+The former ScopeTypeEnum constants.
+
+Deprecated: use the Type fields, e.g. Type.Global.
+*/
+const (
+	ScopeTypeGlobal  = typeGlobal
+	ScopeTypeLocal   = typeLocal
+	ScopeTypeWith    = typeWith
+	ScopeTypeClosure = typeClosure
+	ScopeTypeCatch   = typeCatch
+	ScopeTypeBlock   = typeBlock
+	ScopeTypeScript  = typeScript
+	ScopeTypeEval    = typeEval
+	ScopeTypeModule  = typeModule
+)
@@ -0,0 +1,22 @@
+package orientation
+
+/*
+ClearOverrideResult is the former name of ClearDeviceOrientationOverrideResult.
+
+Deprecated: use ClearDeviceOrientationOverrideResult.
+*/
+type ClearOverrideResult = ClearDeviceOrientationOverrideResult
+
+/*
+SetOverrideParams is the former name of SetDeviceOrientationOverrideParams.
+
+Deprecated: use SetDeviceOrientationOverrideParams.
+*/
+type SetOverrideParams = SetDeviceOrientationOverrideParams
+
+/*
+SetOverrideResult is the former name of SetDeviceOrientationOverrideResult.
+
+Deprecated: use SetDeviceOrientationOverrideResult.
+*/
+type SetOverrideResult = SetDeviceOrientationOverrideResult
@@ -1,11 +1,29 @@
 package dom
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
 )
 
 // Regression protection for https://github.com/mkenney/go-chrome/pull/89
 func TestDOMQuadType(t *testing.T) {
-	t.Logf("%+v", Quad{0, 1.1})
-	t.Logf("%+v", Quad([2]float64{0, 1.1}))
+	quad := Quad{0, 1.1, 2, 3.3, 4, 5.5, 6, 7.7}
+
+	result, err := json.Marshal(quad)
+	if nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+	if `[0,1.1,2,3.3,4,5.5,6,7.7]` != string(result) {
+		t.Errorf("Expected '[0,1.1,2,3.3,4,5.5,6,7.7]', got '%s'", result)
+	}
+
+	model := &BoxModel{}
+	err = json.Unmarshal([]byte(`{"content":[0,1.1,2,3.3,4,5.5,6,7.7],"width":6,"height":7}`), model)
+	if nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+	if !reflect.DeepEqual(quad, model.Content) {
+		t.Errorf("Expected %v, got %v", quad, model.Content)
+	}
 }
@@ -0,0 +1,15 @@
+package snapshot
+
+/*
+GetParams is the former name of GetSnapshotParams.
+
+Deprecated: use GetSnapshotParams.
+*/
+type GetParams = GetSnapshotParams
+
+/*
+GetResult is the former name of GetSnapshotResult.
+
+Deprecated: use GetSnapshotResult.
+*/
+type GetResult = GetSnapshotResult
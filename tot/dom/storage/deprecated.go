@@ -0,0 +1,78 @@
+package storage
+
+/*
+GetItemsParams is the former name of GetDOMStorageItemsParams.
+
+Deprecated: use GetDOMStorageItemsParams.
+*/
+type GetItemsParams = GetDOMStorageItemsParams
+
+/*
+GetItemsResult is the former name of GetDOMStorageItemsResult.
+
+Deprecated: use GetDOMStorageItemsResult.
+*/
+type GetItemsResult = GetDOMStorageItemsResult
+
+/*
+ID is the former name of StorageID.
+
+Deprecated: use StorageID.
+*/
+type ID = StorageID
+
+/*
+ItemAddedEvent is the former name of DOMStorageItemAddedEvent.
+
+Deprecated: use DOMStorageItemAddedEvent.
+*/
+type ItemAddedEvent = DOMStorageItemAddedEvent
+
+/*
+ItemRemovedEvent is the former name of DOMStorageItemRemovedEvent.
+
+Deprecated: use DOMStorageItemRemovedEvent.
+*/
+type ItemRemovedEvent = DOMStorageItemRemovedEvent
+
+/*
+ItemUpdatedEvent is the former name of DOMStorageItemUpdatedEvent.
+
+Deprecated: use DOMStorageItemUpdatedEvent.
+*/
+type ItemUpdatedEvent = DOMStorageItemUpdatedEvent
+
+/*
+ItemsClearedEvent is the former name of DOMStorageItemsClearedEvent.
+
+Deprecated: use DOMStorageItemsClearedEvent.
+*/
+type ItemsClearedEvent = DOMStorageItemsClearedEvent
+
+/*
+RemoveItemParams is the former name of RemoveDOMStorageItemParams.
+
+Deprecated: use RemoveDOMStorageItemParams.
+*/
+type RemoveItemParams = RemoveDOMStorageItemParams
+
+/*
+RemoveItemResult is the former name of RemoveDOMStorageItemResult.
+
+Deprecated: use RemoveDOMStorageItemResult.
+*/
+type RemoveItemResult = RemoveDOMStorageItemResult
+
+/*
+SetItemParams is the former name of SetDOMStorageItemParams.
+
+Deprecated: use SetDOMStorageItemParams.
+*/
+type SetItemParams = SetDOMStorageItemParams
+
+/*
+SetItemResult is the former name of SetDOMStorageItemResult.
+
+Deprecated: use SetDOMStorageItemResult.
+*/
+type SetItemResult = SetDOMStorageItemResult
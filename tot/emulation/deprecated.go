@@ -0,0 +1,15 @@
+package emulation
+
+/*
+OrientationTypeEnum is the former name of TypeEnum.
+
+Deprecated: use TypeEnum.
+*/
+type OrientationTypeEnum = TypeEnum
+
+/*
+OrientationType is the former name of Type.
+
+Deprecated: use Type.
+*/
+var OrientationType = Type
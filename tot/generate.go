@@ -0,0 +1,10 @@
+package chrome
+
+/*
+The domain packages, their socket protocol wrappers, the Protocoller interface
+and the Tab accessors are generated from the protocol definitions in the
+protocol directory. To track a new tip-of-tree release replace the definition
+files and run go generate. See cmd/cdtpgen for details.
+*/
+
+//go:generate go run ../cmd/cdtpgen -browser protocol/browser_protocol.json -js protocol/js_protocol.json -out . -protocoller -surface
@@ -0,0 +1,15 @@
+package db
+
+/*
+KeyTypeEnum is the former name of TypeEnum.
+
+Deprecated: use TypeEnum.
+*/
+type KeyTypeEnum = TypeEnum
+
+/*
+KeyType is the former name of Type.
+
+Deprecated: use Type.
+*/
+var KeyType = Type
@@ -0,0 +1,71 @@
+package input
+
+/*
+SetIgnoreEventsParams is the former name of SetIgnoreInputEventsParams.
+
+Deprecated: use SetIgnoreInputEventsParams.
+*/
+type SetIgnoreEventsParams = SetIgnoreInputEventsParams
+
+/*
+SetIgnoreEventsResult is the former name of SetIgnoreInputEventsResult.
+
+Deprecated: use SetIgnoreInputEventsResult.
+*/
+type SetIgnoreEventsResult = SetIgnoreInputEventsResult
+
+/*
+ButtonEventEnum is the former name of MouseButtonEnum.
+
+Deprecated: use MouseButtonEnum.
+*/
+type ButtonEventEnum = MouseButtonEnum
+
+/*
+ButtonEvent is the former name of MouseButton.
+
+Deprecated: use MouseButton.
+*/
+var ButtonEvent = MouseButton
+
+/*
+KeyEventEnum is the former name of DispatchKeyEventTypeEnum.
+
+Deprecated: use DispatchKeyEventTypeEnum.
+*/
+type KeyEventEnum = DispatchKeyEventTypeEnum
+
+/*
+KeyEvent is the former name of DispatchKeyEventType.
+
+Deprecated: use DispatchKeyEventType.
+*/
+var KeyEvent = DispatchKeyEventType
+
+/*
+MouseEventEnum is the former name of DispatchMouseEventTypeEnum.
+
+Deprecated: use DispatchMouseEventTypeEnum.
+*/
+type MouseEventEnum = DispatchMouseEventTypeEnum
+
+/*
+MouseEvent is the former name of DispatchMouseEventType.
+
+Deprecated: use DispatchMouseEventType.
+*/
+var MouseEvent = DispatchMouseEventType
+
+/*
+TouchEventEnum is the former name of DispatchTouchEventTypeEnum.
+
+Deprecated: use DispatchTouchEventTypeEnum.
+*/
+type TouchEventEnum = DispatchTouchEventTypeEnum
+
+/*
+TouchEvent is the former name of DispatchTouchEventType.
+
+Deprecated: use DispatchTouchEventType.
+*/
+var TouchEvent = DispatchTouchEventType
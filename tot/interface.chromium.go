@@ -11,7 +11,7 @@ type Chromium interface {
 	Address() string
 
 	// Binary returns the path to the Chromium binary. Should return a sane
-	// default value such as '/usr/bin/google-chrome'.
+	// default value such as the binary found by FindBinary.
 	Binary() string
 
 	// Close ends the Chromium process and cleans up.
@@ -0,0 +1,22 @@
+package tree
+
+/*
+DidChangeEvent is the former name of LayerTreeDidChangeEvent.
+
+Deprecated: use LayerTreeDidChangeEvent.
+*/
+type DidChangeEvent = LayerTreeDidChangeEvent
+
+/*
+RectTypeEnum is the former name of TypeEnum.
+
+Deprecated: use TypeEnum.
+*/
+type RectTypeEnum = TypeEnum
+
+/*
+RectType is the former name of Type.
+
+Deprecated: use Type.
+*/
+var RectType = Type
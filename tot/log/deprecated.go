@@ -0,0 +1,8 @@
+package log
+
+/*
+Entry is the former name of LogEntry.
+
+Deprecated: use LogEntry.
+*/
+type Entry = LogEntry
@@ -1,6 +1,8 @@
 package chrome
 
 import (
+	"context"
+	"encoding/json"
 	"net/url"
 
 	"github.com/mkenney/go-chrome/tot/socket"
@@ -14,33 +16,46 @@ func NewMockSocket(url *url.URL) *MockSocket {
 
 	mockSocket.accessibility = &socket.AccessibilityProtocol{Socket: mockSocket}
 	mockSocket.animation = &socket.AnimationProtocol{Socket: mockSocket}
-	mockSocket.applicationCache = &socket.ApplicationCacheProtocol{Socket: mockSocket}
 	mockSocket.audits = &socket.AuditsProtocol{Socket: mockSocket}
+	mockSocket.autofill = &socket.AutofillProtocol{Socket: mockSocket}
+	mockSocket.backgroundService = &socket.BackgroundServiceProtocol{Socket: mockSocket}
+	mockSocket.bluetoothEmulation = &socket.BluetoothEmulationProtocol{Socket: mockSocket}
 	mockSocket.browser = &socket.BrowserProtocol{Socket: mockSocket}
 	mockSocket.cacheStorage = &socket.CacheStorageProtocol{Socket: mockSocket}
+	mockSocket.cast = &socket.CastProtocol{Socket: mockSocket}
 	mockSocket.console = &socket.ConsoleProtocol{Socket: mockSocket}
 	mockSocket.css = &socket.CSSProtocol{Socket: mockSocket}
-	mockSocket.database = &socket.DatabaseProtocol{Socket: mockSocket}
 	mockSocket.debugger = &socket.DebuggerProtocol{Socket: mockSocket}
+	mockSocket.deviceAccess = &socket.DeviceAccessProtocol{Socket: mockSocket}
 	mockSocket.deviceOrientation = &socket.DeviceOrientationProtocol{Socket: mockSocket}
+	mockSocket.dom = &socket.DOMProtocol{Socket: mockSocket}
 	mockSocket.domDebugger = &socket.DOMDebuggerProtocol{Socket: mockSocket}
 	mockSocket.domSnapshot = &socket.DOMSnapshotProtocol{Socket: mockSocket}
 	mockSocket.domStorage = &socket.DOMStorageProtocol{Socket: mockSocket}
-	mockSocket.dom = &socket.DOMProtocol{Socket: mockSocket}
 	mockSocket.emulation = &socket.EmulationProtocol{Socket: mockSocket}
+	mockSocket.eventBreakpoints = &socket.EventBreakpointsProtocol{Socket: mockSocket}
+	mockSocket.extensions = &socket.ExtensionsProtocol{Socket: mockSocket}
+	mockSocket.fedCm = &socket.FedCmProtocol{Socket: mockSocket}
+	mockSocket.fetch = &socket.FetchProtocol{Socket: mockSocket}
+	mockSocket.fileSystem = &socket.FileSystemProtocol{Socket: mockSocket}
 	mockSocket.headlessExperimental = &socket.HeadlessExperimentalProtocol{Socket: mockSocket}
 	mockSocket.heapProfiler = &socket.HeapProfilerProtocol{Socket: mockSocket}
 	mockSocket.indexedDB = &socket.IndexedDBProtocol{Socket: mockSocket}
 	mockSocket.input = &socket.InputProtocol{Socket: mockSocket}
+	mockSocket.inspector = &socket.InspectorProtocol{Socket: mockSocket}
 	mockSocket.io = &socket.IOProtocol{Socket: mockSocket}
 	mockSocket.layerTree = &socket.LayerTreeProtocol{Socket: mockSocket}
 	mockSocket.log = &socket.LogProtocol{Socket: mockSocket}
+	mockSocket.media = &socket.MediaProtocol{Socket: mockSocket}
 	mockSocket.memory = &socket.MemoryProtocol{Socket: mockSocket}
 	mockSocket.network = &socket.NetworkProtocol{Socket: mockSocket}
 	mockSocket.overlay = &socket.OverlayProtocol{Socket: mockSocket}
 	mockSocket.page = &socket.PageProtocol{Socket: mockSocket}
 	mockSocket.performance = &socket.PerformanceProtocol{Socket: mockSocket}
+	mockSocket.performanceTimeline = &socket.PerformanceTimelineProtocol{Socket: mockSocket}
+	mockSocket.preload = &socket.PreloadProtocol{Socket: mockSocket}
 	mockSocket.profiler = &socket.ProfilerProtocol{Socket: mockSocket}
+	mockSocket.pwa = &socket.PWAProtocol{Socket: mockSocket}
 	mockSocket.runtime = &socket.RuntimeProtocol{Socket: mockSocket}
 	mockSocket.schema = &socket.SchemaProtocol{Socket: mockSocket}
 	mockSocket.security = &socket.SecurityProtocol{Socket: mockSocket}
@@ -50,6 +65,8 @@ func NewMockSocket(url *url.URL) *MockSocket {
 	mockSocket.target = &socket.TargetProtocol{Socket: mockSocket}
 	mockSocket.tethering = &socket.TetheringProtocol{Socket: mockSocket}
 	mockSocket.tracing = &socket.TracingProtocol{Socket: mockSocket}
+	mockSocket.webAudio = &socket.WebAudioProtocol{Socket: mockSocket}
+	mockSocket.webAuthn = &socket.WebAuthnProtocol{Socket: mockSocket}
 
 	return mockSocket
 }
@@ -65,33 +82,46 @@ type MockSocket struct {
 	// Protocol interfaces for the API.
 	accessibility        *socket.AccessibilityProtocol
 	animation            *socket.AnimationProtocol
-	applicationCache     *socket.ApplicationCacheProtocol
 	audits               *socket.AuditsProtocol
+	autofill             *socket.AutofillProtocol
+	backgroundService    *socket.BackgroundServiceProtocol
+	bluetoothEmulation   *socket.BluetoothEmulationProtocol
 	browser              *socket.BrowserProtocol
 	cacheStorage         *socket.CacheStorageProtocol
+	cast                 *socket.CastProtocol
 	console              *socket.ConsoleProtocol
 	css                  *socket.CSSProtocol
-	database             *socket.DatabaseProtocol
 	debugger             *socket.DebuggerProtocol
+	deviceAccess         *socket.DeviceAccessProtocol
 	deviceOrientation    *socket.DeviceOrientationProtocol
+	dom                  *socket.DOMProtocol
 	domDebugger          *socket.DOMDebuggerProtocol
 	domSnapshot          *socket.DOMSnapshotProtocol
 	domStorage           *socket.DOMStorageProtocol
-	dom                  *socket.DOMProtocol
 	emulation            *socket.EmulationProtocol
+	eventBreakpoints     *socket.EventBreakpointsProtocol
+	extensions           *socket.ExtensionsProtocol
+	fedCm                *socket.FedCmProtocol
+	fetch                *socket.FetchProtocol
+	fileSystem           *socket.FileSystemProtocol
 	headlessExperimental *socket.HeadlessExperimentalProtocol
 	heapProfiler         *socket.HeapProfilerProtocol
 	indexedDB            *socket.IndexedDBProtocol
 	input                *socket.InputProtocol
+	inspector            *socket.InspectorProtocol
 	io                   *socket.IOProtocol
 	layerTree            *socket.LayerTreeProtocol
 	log                  *socket.LogProtocol
+	media                *socket.MediaProtocol
 	memory               *socket.MemoryProtocol
 	network              *socket.NetworkProtocol
 	overlay              *socket.OverlayProtocol
 	page                 *socket.PageProtocol
 	performance          *socket.PerformanceProtocol
+	performanceTimeline  *socket.PerformanceTimelineProtocol
+	preload              *socket.PreloadProtocol
 	profiler             *socket.ProfilerProtocol
+	pwa                  *socket.PWAProtocol
 	runtime              *socket.RuntimeProtocol
 	schema               *socket.SchemaProtocol
 	security             *socket.SecurityProtocol
@@ -101,6 +131,8 @@ type MockSocket struct {
 	target               *socket.TargetProtocol
 	tethering            *socket.TetheringProtocol
 	tracing              *socket.TracingProtocol
+	webAudio             *socket.WebAudioProtocol
+	webAuthn             *socket.WebAuthnProtocol
 }
 
 /*
@@ -111,6 +143,13 @@ func (socket *MockSocket) AddEventHandler(
 ) {
 }
 
+/*
+Call is a Socketer implementation.
+*/
+func (socket *MockSocket) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	return nil
+}
+
 /*
 CurCommandID is a Socketer implementation.
 */
@@ -119,6 +158,27 @@ func (socket *MockSocket) CurCommandID() int {
 	return id
 }
 
+/*
+Done is a Socketer implementation.
+*/
+func (socket *MockSocket) Done() <-chan struct{} {
+	return nil
+}
+
+/*
+EnableDomain is a Socketer implementation.
+*/
+func (socket *MockSocket) EnableDomain(ctx context.Context, domain string, params interface{}) (*socket.DomainHandle, error) {
+	return nil, nil
+}
+
+/*
+EnabledDomains is a Socketer implementation.
+*/
+func (socket *MockSocket) EnabledDomains() []*socket.EnabledDomain {
+	return nil
+}
+
 func (socket *MockSocket) Errors() chan error {
 	return socket.errCh
 }
@@ -141,6 +201,27 @@ func (socket *MockSocket) NextCommandID() int {
 	return socket.commandID
 }
 
+/*
+On is a Socketer implementation.
+*/
+func (socket *MockSocket) On(method string, callback func(params json.RawMessage)) *socket.Subscription {
+	return nil
+}
+
+/*
+OnPattern is a Socketer implementation.
+*/
+func (socket *MockSocket) OnPattern(pattern string, callback func(method string, params json.RawMessage)) *socket.Subscription {
+	return nil
+}
+
+/*
+Pending is a Socketer implementation.
+*/
+func (socket *MockSocket) Pending() []*socket.PendingCommand {
+	return nil
+}
+
 /*
 RemoveEventHandler is a Socketer implementation.
 */
@@ -150,6 +231,13 @@ func (socket *MockSocket) RemoveEventHandler(
 	return nil
 }
 
+/*
+RestoreDomains is a Socketer implementation.
+*/
+func (socket *MockSocket) RestoreDomains(ctx context.Context, domains []*socket.EnabledDomain) error {
+	return nil
+}
+
 /*
 SendCommand is a Socketer implementation.
 */
@@ -157,6 +245,13 @@ func (socket *MockSocket) SendCommand(command socket.Commander) chan *socket.Res
 	return command.Response()
 }
 
+/*
+SendCommandContext is a Socketer implementation.
+*/
+func (socket *MockSocket) SendCommandContext(ctx context.Context, command socket.Commander) chan *socket.Response {
+	return command.Response()
+}
+
 /*
 Stop is a Socketer implementation.
 */
@@ -170,6 +265,13 @@ func (socket *MockSocket) URL() *url.URL {
 	return socket.url
 }
 
+/*
+WaitForEvent is a Socketer implementation.
+*/
+func (socket *MockSocket) WaitForEvent(ctx context.Context, name string, predicate func(event *socket.Event) bool) <-chan *socket.Event {
+	return nil
+}
+
 /*
 Accessibility is a Protocoller implementation.
 */
@@ -185,17 +287,31 @@ func (socket *MockSocket) Animation() *socket.AnimationProtocol {
 }
 
 /*
-ApplicationCache is a Protocoller implementation.
+Audits is a Protocoller implementation.
 */
-func (socket *MockSocket) ApplicationCache() *socket.ApplicationCacheProtocol {
-	return socket.applicationCache
+func (socket *MockSocket) Audits() *socket.AuditsProtocol {
+	return socket.audits
 }
 
 /*
-Audits is a Protocoller implementation.
+Autofill is a Protocoller implementation.
 */
-func (socket *MockSocket) Audits() *socket.AuditsProtocol {
-	return socket.audits
+func (socket *MockSocket) Autofill() *socket.AutofillProtocol {
+	return socket.autofill
+}
+
+/*
+BackgroundService is a Protocoller implementation.
+*/
+func (socket *MockSocket) BackgroundService() *socket.BackgroundServiceProtocol {
+	return socket.backgroundService
+}
+
+/*
+BluetoothEmulation is a Protocoller implementation.
+*/
+func (socket *MockSocket) BluetoothEmulation() *socket.BluetoothEmulationProtocol {
+	return socket.bluetoothEmulation
 }
 
 /*
@@ -212,6 +328,13 @@ func (socket *MockSocket) CacheStorage() *socket.CacheStorageProtocol {
 	return socket.cacheStorage
 }
 
+/*
+Cast is a Protocoller implementation.
+*/
+func (socket *MockSocket) Cast() *socket.CastProtocol {
+	return socket.cast
+}
+
 /*
 Console is a Protocoller implementation.
 */
@@ -227,17 +350,17 @@ func (socket *MockSocket) CSS() *socket.CSSProtocol {
 }
 
 /*
-Database is a Protocoller implementation.
+Debugger is a Protocoller implementation.
 */
-func (socket *MockSocket) Database() *socket.DatabaseProtocol {
-	return socket.database
+func (socket *MockSocket) Debugger() *socket.DebuggerProtocol {
+	return socket.debugger
 }
 
 /*
-Debugger is a Protocoller implementation.
+DeviceAccess is a Protocoller implementation.
 */
-func (socket *MockSocket) Debugger() *socket.DebuggerProtocol {
-	return socket.debugger
+func (socket *MockSocket) DeviceAccess() *socket.DeviceAccessProtocol {
+	return socket.deviceAccess
 }
 
 /*
@@ -247,6 +370,13 @@ func (socket *MockSocket) DeviceOrientation() *socket.DeviceOrientationProtocol
 	return socket.deviceOrientation
 }
 
+/*
+DOM is a Protocoller implementation.
+*/
+func (socket *MockSocket) DOM() *socket.DOMProtocol {
+	return socket.dom
+}
+
 /*
 DOMDebugger is a Protocoller implementation.
 */
@@ -269,17 +399,45 @@ func (socket *MockSocket) DOMStorage() *socket.DOMStorageProtocol {
 }
 
 /*
-DOM is a Protocoller implementation.
+Emulation is a Protocoller implementation.
 */
-func (socket *MockSocket) DOM() *socket.DOMProtocol {
-	return socket.dom
+func (socket *MockSocket) Emulation() *socket.EmulationProtocol {
+	return socket.emulation
 }
 
 /*
-Emulation is a Protocoller implementation.
+EventBreakpoints is a Protocoller implementation.
 */
-func (socket *MockSocket) Emulation() *socket.EmulationProtocol {
-	return socket.emulation
+func (socket *MockSocket) EventBreakpoints() *socket.EventBreakpointsProtocol {
+	return socket.eventBreakpoints
+}
+
+/*
+Extensions is a Protocoller implementation.
+*/
+func (socket *MockSocket) Extensions() *socket.ExtensionsProtocol {
+	return socket.extensions
+}
+
+/*
+FedCm is a Protocoller implementation.
+*/
+func (socket *MockSocket) FedCm() *socket.FedCmProtocol {
+	return socket.fedCm
+}
+
+/*
+Fetch is a Protocoller implementation.
+*/
+func (socket *MockSocket) Fetch() *socket.FetchProtocol {
+	return socket.fetch
+}
+
+/*
+FileSystem is a Protocoller implementation.
+*/
+func (socket *MockSocket) FileSystem() *socket.FileSystemProtocol {
+	return socket.fileSystem
 }
 
 /*
@@ -310,6 +468,13 @@ func (socket *MockSocket) Input() *socket.InputProtocol {
 	return socket.input
 }
 
+/*
+Inspector is a Protocoller implementation.
+*/
+func (socket *MockSocket) Inspector() *socket.InspectorProtocol {
+	return socket.inspector
+}
+
 /*
 IO is a Protocoller implementation.
 */
@@ -331,6 +496,13 @@ func (socket *MockSocket) Log() *socket.LogProtocol {
 	return socket.log
 }
 
+/*
+Media is a Protocoller implementation.
+*/
+func (socket *MockSocket) Media() *socket.MediaProtocol {
+	return socket.media
+}
+
 /*
 Memory is a Protocoller implementation.
 */
@@ -366,6 +538,20 @@ func (socket *MockSocket) Performance() *socket.PerformanceProtocol {
 	return socket.performance
 }
 
+/*
+PerformanceTimeline is a Protocoller implementation.
+*/
+func (socket *MockSocket) PerformanceTimeline() *socket.PerformanceTimelineProtocol {
+	return socket.performanceTimeline
+}
+
+/*
+Preload is a Protocoller implementation.
+*/
+func (socket *MockSocket) Preload() *socket.PreloadProtocol {
+	return socket.preload
+}
+
 /*
 Profiler is a Protocoller implementation.
 */
@@ -373,6 +559,13 @@ func (socket *MockSocket) Profiler() *socket.ProfilerProtocol {
 	return socket.profiler
 }
 
+/*
+PWA is a Protocoller implementation.
+*/
+func (socket *MockSocket) PWA() *socket.PWAProtocol {
+	return socket.pwa
+}
+
 /*
 Runtime is a Protocoller implementation.
 */
@@ -435,3 +628,17 @@ Tracing is a Protocoller implementation.
 func (socket *MockSocket) Tracing() *socket.TracingProtocol {
 	return socket.tracing
 }
+
+/*
+WebAudio is a Protocoller implementation.
+*/
+func (socket *MockSocket) WebAudio() *socket.WebAudioProtocol {
+	return socket.webAudio
+}
+
+/*
+WebAuthn is a Protocoller implementation.
+*/
+func (socket *MockSocket) WebAuthn() *socket.WebAuthnProtocol {
+	return socket.webAuthn
+}
@@ -0,0 +1,50 @@
+package network
+
+/*
+CanEmulateConditionsResult is the former name of CanEmulateNetworkConditionsResult.
+
+Deprecated: use CanEmulateNetworkConditionsResult.
+*/
+type CanEmulateConditionsResult = CanEmulateNetworkConditionsResult
+
+/*
+EmulateConditionsParams is the former name of EmulateNetworkConditionsParams.
+
+Deprecated: use EmulateNetworkConditionsParams.
+*/
+type EmulateConditionsParams = EmulateNetworkConditionsParams
+
+/*
+EmulateConditionsResult is the former name of EmulateNetworkConditionsResult.
+
+Deprecated: use EmulateNetworkConditionsResult.
+*/
+type EmulateConditionsResult = EmulateNetworkConditionsResult
+
+/*
+ChallengeResponseEnum is the former name of AuthChallengeResponseResponseEnum.
+
+Deprecated: use AuthChallengeResponseResponseEnum.
+*/
+type ChallengeResponseEnum = AuthChallengeResponseResponseEnum
+
+/*
+ChallengeResponse is the former name of AuthChallengeResponseResponse.
+
+Deprecated: use AuthChallengeResponseResponse.
+*/
+var ChallengeResponse = AuthChallengeResponseResponse
+
+/*
+InitiatorTypeEnum is the former name of TypeEnum.
+
+Deprecated: use TypeEnum.
+*/
+type InitiatorTypeEnum = TypeEnum
+
+/*
+InitiatorType is the former name of Type.
+
+Deprecated: use Type.
+*/
+var InitiatorType = Type
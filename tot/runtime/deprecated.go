@@ -0,0 +1,44 @@
+package runtime
+
+/*
+CallTypeEnum is the former name of ConsoleAPICalledTypeEnum.
+
+Deprecated: use ConsoleAPICalledTypeEnum.
+*/
+type CallTypeEnum = ConsoleAPICalledTypeEnum
+
+/*
+CallType is the former name of ConsoleAPICalledType.
+
+Deprecated: use ConsoleAPICalledType.
+*/
+var CallType = ConsoleAPICalledType
+
+/*
+ObjectSubtypeEnum is the former name of SubtypeEnum.
+
+Deprecated: use SubtypeEnum.
+*/
+type ObjectSubtypeEnum = SubtypeEnum
+
+/*
+ObjectSubtype is the former name of Subtype.
+
+Deprecated: use Subtype.
+*/
+var ObjectSubtype = Subtype
+
+/*
+ObjectTypeEnum is the former name of RemoteObjectTypeEnum.
+
+Deprecated: use RemoteObjectTypeEnum.
+*/
+type ObjectTypeEnum = RemoteObjectTypeEnum
+
+/*
+ObjectType is the former name of RemoteObjectType. The
+ObjectType.Accessor value was removed from the protocol.
+
+Deprecated: use RemoteObjectType.
+*/
+var ObjectType = RemoteObjectType
@@ -0,0 +1,29 @@
+package security
+
+/*
+StateChangedEvent is the former name of SecurityStateChangedEvent.
+
+Deprecated: use SecurityStateChangedEvent.
+*/
+type StateChangedEvent = SecurityStateChangedEvent
+
+/*
+StateExplanation is the former name of SecurityStateExplanation.
+
+Deprecated: use SecurityStateExplanation.
+*/
+type StateExplanation = SecurityStateExplanation
+
+/*
+StateEnum is the former name of SecurityStateEnum.
+
+Deprecated: use SecurityStateEnum.
+*/
+type StateEnum = SecurityStateEnum
+
+/*
+State is the former name of SecurityState.
+
+Deprecated: use SecurityState.
+*/
+var State = SecurityState
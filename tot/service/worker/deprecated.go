@@ -0,0 +1,71 @@
+package worker
+
+/*
+ErrorMessage is the former name of ServiceWorkerErrorMessage.
+
+Deprecated: use ServiceWorkerErrorMessage.
+*/
+type ErrorMessage = ServiceWorkerErrorMessage
+
+/*
+ErrorReportedEvent is the former name of WorkerErrorReportedEvent.
+
+Deprecated: use WorkerErrorReportedEvent.
+*/
+type ErrorReportedEvent = WorkerErrorReportedEvent
+
+/*
+Registration is the former name of ServiceWorkerRegistration.
+
+Deprecated: use ServiceWorkerRegistration.
+*/
+type Registration = ServiceWorkerRegistration
+
+/*
+RegistrationUpdatedEvent is the former name of WorkerRegistrationUpdatedEvent.
+
+Deprecated: use WorkerRegistrationUpdatedEvent.
+*/
+type RegistrationUpdatedEvent = WorkerRegistrationUpdatedEvent
+
+/*
+Version is the former name of ServiceWorkerVersion.
+
+Deprecated: use ServiceWorkerVersion.
+*/
+type Version = ServiceWorkerVersion
+
+/*
+VersionUpdatedEvent is the former name of WorkerVersionUpdatedEvent.
+
+Deprecated: use WorkerVersionUpdatedEvent.
+*/
+type VersionUpdatedEvent = WorkerVersionUpdatedEvent
+
+/*
+VersionRunningStatusEnum is the former name of ServiceWorkerVersionRunningStatusEnum.
+
+Deprecated: use ServiceWorkerVersionRunningStatusEnum.
+*/
+type VersionRunningStatusEnum = ServiceWorkerVersionRunningStatusEnum
+
+/*
+VersionRunningStatus is the former name of ServiceWorkerVersionRunningStatus.
+
+Deprecated: use ServiceWorkerVersionRunningStatus.
+*/
+var VersionRunningStatus = ServiceWorkerVersionRunningStatus
+
+/*
+VersionStatusEnum is the former name of ServiceWorkerVersionStatusEnum.
+
+Deprecated: use ServiceWorkerVersionStatusEnum.
+*/
+type VersionStatusEnum = ServiceWorkerVersionStatusEnum
+
+/*
+VersionStatus is the former name of ServiceWorkerVersionStatus.
+
+Deprecated: use ServiceWorkerVersionStatus.
+*/
+var VersionStatus = ServiceWorkerVersionStatus
@@ -10,6 +10,13 @@ type CommandMapper interface {
 	// Get retrieves a command from the stack.
 	Get(commandID int) (Commander, error)
 
+	// Pending returns a description of every command in the stack, ordered
+	// by command ID.
+	Pending() []*PendingCommand
+
+	// Pop retrieves and removes a command from the stack.
+	Pop(commandID int) (Commander, error)
+
 	// Set sets a command in the stack.
 	Set(command Commander)
 }
@@ -14,6 +14,10 @@ type EventHandlerMapper interface {
 	// Get retrieves the entire stack of handlers for an event.
 	Get(eventName string) ([]EventHandler, error)
 
+	// Match returns the handlers an event is delivered to: the handlers for
+	// its name, its domain pattern and the '*' pattern.
+	Match(eventName string) []EventHandler
+
 	// Lock locks the sync mutex.
 	Lock()
 
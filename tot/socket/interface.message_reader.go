@@ -0,0 +1,15 @@
+package socket
+
+import (
+	"bytes"
+)
+
+/*
+MessageReader is implemented by WebSocketer connections that can read the raw
+bytes of a message. The socket read loop reads these connections into pooled
+buffers instead of calling ReadJSON.
+*/
+type MessageReader interface {
+	// ReadMessage reads the next message from the connection into buf.
+	ReadMessage(buf *bytes.Buffer) error
+}
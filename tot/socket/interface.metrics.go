@@ -0,0 +1,23 @@
+package socket
+
+import (
+	"time"
+)
+
+/*
+Metrics defines the interface for collecting socket metrics, see WithMetrics.
+Implementations must be safe for concurrent use, a single Metrics instance may
+be shared by many sockets.
+*/
+type Metrics interface {
+	// CommandStarted is called when a command is sent.
+	CommandStarted(method string)
+
+	// CommandFinished is called when a command completes. err is the
+	// protocol error of the response or nil if the command succeeded.
+	CommandFinished(method string, latency time.Duration, err *Error)
+
+	// EventReceived is called for every event read from the websocket
+	// connection.
+	EventReceived(name string)
+}
@@ -1,6 +1,8 @@
 package socket
 
 import (
+	"context"
+	"encoding/json"
 	"net/url"
 )
 
@@ -12,9 +14,23 @@ type Socketer interface {
 	// event.
 	AddEventHandler(handler EventHandler)
 
+	// Call sends a command for any protocol method and unmarshals its result.
+	Call(ctx context.Context, method string, params interface{}, result interface{}) error
+
 	// CurCommandID returns the latest command ID.
 	CurCommandID() int
 
+	// Done returns a channel that is closed when the socket is stopped.
+	Done() <-chan struct{}
+
+	// EnableDomain enables a protocol domain and returns a
+	// reference-counted handle to it.
+	EnableDomain(ctx context.Context, domain string, params interface{}) (*DomainHandle, error)
+
+	// EnabledDomains returns the protocol domains currently enabled on the
+	// socket.
+	EnabledDomains() []*EnabledDomain
+
 	// Listen starts the socket read loop and delivers messages to
 	// HandleCommand() and HandleEvent() as appropriate.
 	Listen() error
@@ -22,17 +38,40 @@ type Socketer interface {
 	// NextCommandID generates and returns the next command ID.
 	NextCommandID() int
 
+	// On adds an event handler for any protocol event.
+	On(method string, callback func(params json.RawMessage)) *Subscription
+
+	// OnPattern adds an event handler for the events matching a pattern such
+	// as 'Network.*' or '*'.
+	OnPattern(pattern string, callback func(method string, params json.RawMessage)) *Subscription
+
+	// Pending returns the commands that are waiting for a response, ordered
+	// by command ID.
+	Pending() []*PendingCommand
+
 	// RemoveEventHandler removes a handler from the stack of listeners for an
 	// event.
 	RemoveEventHandler(handler EventHandler) error
 
+	// RestoreDomains enables a set of domains, e.g. those returned by
+	// EnabledDomains for another session.
+	RestoreDomains(ctx context.Context, domains []*EnabledDomain) error
+
 	// SendCommand delivers a command payload to the websocket connection.
 	SendCommand(command Commander) chan *Response
 
+	// SendCommandContext delivers a command payload to the websocket
+	// connection and abandons the command if the context is done before a
+	// response is received.
+	SendCommandContext(ctx context.Context, command Commander) chan *Response
+
 	// Stop signals the socket read loop to stop listening for data and close
 	// the websocket connection.
 	Stop()
 
 	// URL returns the URL of the websocket connection.
 	URL() *url.URL
+
+	// WaitForEvent waits for the first event matching a name and a predicate.
+	WaitForEvent(ctx context.Context, name string, predicate func(event *Event) bool) <-chan *Event
 }
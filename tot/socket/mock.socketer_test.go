@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/logger"
 )
 
 func init() {
@@ -18,61 +19,33 @@ func init() {
 /*
 NewMock returns a Chromium Socketer mock for unit testing
 */
-func NewMock(socketURL *url.URL) *Socket {
+func NewMock(socketURL *url.URL, options ...Option) *Socket {
 	ctx, cancel := context.WithCancel(context.Background())
 	socket := &Socket{
 		commandIDMux: &sync.Mutex{},
 		commands:     NewCommandMap(),
+		domains:      newDomainTracker(),
 		handlers:     NewEventHandlerMap(),
+		logger:       logger.Nop(),
+		middleware:   newMiddleware(),
 		mux:          &sync.Mutex{},
 		newSocket:    NewMockWebsocket,
+		sessions:     make(map[string]*Session),
+		sessionsMux:  &sync.Mutex{},
 		socketID:     NextSocketID(),
+		stateMux:     &sync.Mutex{},
 		url:          socketURL,
 
 		ctx:    ctx,
 		cancel: cancel,
 		wg:     &sync.WaitGroup{},
 	}
+	for _, option := range options {
+		option(socket)
+	}
 	log.Debugf("Created socket #%d", socket.socketID)
 
-	socket.accessibility = &AccessibilityProtocol{Socket: socket}
-	socket.animation = &AnimationProtocol{Socket: socket}
-	socket.applicationCache = &ApplicationCacheProtocol{Socket: socket}
-	socket.audits = &AuditsProtocol{Socket: socket}
-	socket.browser = &BrowserProtocol{Socket: socket}
-	socket.cacheStorage = &CacheStorageProtocol{Socket: socket}
-	socket.console = &ConsoleProtocol{Socket: socket}
-	socket.css = &CSSProtocol{Socket: socket}
-	socket.database = &DatabaseProtocol{Socket: socket}
-	socket.debugger = &DebuggerProtocol{Socket: socket}
-	socket.deviceOrientation = &DeviceOrientationProtocol{Socket: socket}
-	socket.domDebugger = &DOMDebuggerProtocol{Socket: socket}
-	socket.domSnapshot = &DOMSnapshotProtocol{Socket: socket}
-	socket.domStorage = &DOMStorageProtocol{Socket: socket}
-	socket.dom = &DOMProtocol{Socket: socket}
-	socket.emulation = &EmulationProtocol{Socket: socket}
-	socket.headlessExperimental = &HeadlessExperimentalProtocol{Socket: socket}
-	socket.heapProfiler = &HeapProfilerProtocol{Socket: socket}
-	socket.indexedDB = &IndexedDBProtocol{Socket: socket}
-	socket.input = &InputProtocol{Socket: socket}
-	socket.io = &IOProtocol{Socket: socket}
-	socket.layerTree = &LayerTreeProtocol{Socket: socket}
-	socket.log = &LogProtocol{Socket: socket}
-	socket.memory = &MemoryProtocol{Socket: socket}
-	socket.network = &NetworkProtocol{Socket: socket}
-	socket.overlay = &OverlayProtocol{Socket: socket}
-	socket.page = &PageProtocol{Socket: socket}
-	socket.performance = &PerformanceProtocol{Socket: socket}
-	socket.profiler = &ProfilerProtocol{Socket: socket}
-	socket.runtime = &RuntimeProtocol{Socket: socket}
-	socket.schema = &SchemaProtocol{Socket: socket}
-	socket.security = &SecurityProtocol{Socket: socket}
-	socket.serviceWorker = &ServiceWorkerProtocol{Socket: socket}
-	socket.storage = &StorageProtocol{Socket: socket}
-	socket.systemInfo = &SystemInfoProtocol{Socket: socket}
-	socket.target = &TargetProtocol{Socket: socket}
-	socket.tethering = &TetheringProtocol{Socket: socket}
-	socket.tracing = &TracingProtocol{Socket: socket}
+	socket.protocols = newProtocols(socket)
 
 	return socket
 }
@@ -56,7 +56,7 @@ func (socket *MockChromeWebSocket) ReadJSON(v interface{}) error {
 		socket.sleep = 0
 	}
 
-	for range time.After(time.Millisecond * 10) {
+	for ; ; time.Sleep(time.Millisecond * 10) {
 		if len(socket.mockResponses) > 0 {
 			data = socket.mockResponses[0]
 			socket.mockResponses = socket.mockResponses[1:]
@@ -70,7 +70,6 @@ func (socket *MockChromeWebSocket) ReadJSON(v interface{}) error {
 			return nil
 		}
 	}
-	return nil
 }
 
 /*
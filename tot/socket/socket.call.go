@@ -0,0 +1,77 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+Call sends a command for any protocol method, including methods that don't
+have a protocol wrapper yet, and waits for the response. params is marshalled
+as the command parameters and may be nil. The command result is unmarshalled
+into result unless result is nil, e.g.
+
+	result := struct {
+		FrameTree json.RawMessage `json:"frameTree"`
+	}{}
+	err := soc.Call(ctx, "Page.getFrameTree", nil, &result)
+
+Protocol errors are returned as *ProtocolError, see ErrServerError,
+ErrMethodNotFound and ErrInvalidParams. If the context is done before a
+response is received a codes.SocketCommandCancelled error is returned.
+
+Call is a Socketer implementation.
+*/
+func (socket *Socket) Call(
+	ctx context.Context,
+	method string,
+	params interface{},
+	result interface{},
+) error {
+	return call(ctx, socket, method, params, result)
+}
+
+/*
+Call sends a command for any protocol method in this session and waits for
+the response, see Socket.Call.
+
+Call is a Socketer implementation.
+*/
+func (session *Session) Call(
+	ctx context.Context,
+	method string,
+	params interface{},
+	result interface{},
+) error {
+	return call(ctx, session, method, params, result)
+}
+
+/*
+call sends a command through socket and unmarshals its result.
+*/
+func call(
+	ctx context.Context,
+	socket Socketer,
+	method string,
+	params interface{},
+	result interface{},
+) error {
+	command := NewCommand(socket, method, params)
+	response := <-socket.SendCommandContext(ctx, command)
+	if nil != command.Error() {
+		return command.Error()
+	}
+	if nil != response.Error && 0 != response.Error.Code {
+		return NewProtocolError(method, response.Error)
+	}
+	if nil == result || 0 == len(response.Result) {
+		return nil
+	}
+	if err := json.Unmarshal(response.Result, result); nil != err {
+		return codes.Wrap(err, codes.SocketResultInvalid, fmt.Sprintf("invalid result for command #%d '%s'", command.ID(), method))
+	}
+	return nil
+}
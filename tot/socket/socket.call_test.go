@@ -0,0 +1,75 @@
+package socket
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/codes"
+)
+
+func TestSocketCall(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketCall")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+
+	// The mock websocket answers with the command params.
+	result := map[string]string{}
+	if err := soc.Call(context.Background(), "Browser.newMethod", map[string]string{"key": "value"}, &result); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if "value" != result["key"] {
+		t.Errorf("Expected 'value', got %v", result)
+	}
+	if "Browser.newMethod" != conn.payloads[0].Method {
+		t.Errorf("Expected 'Browser.newMethod', got '%s'", conn.payloads[0].Method)
+	}
+
+	if err := soc.Call(context.Background(), "Browser.newMethod", nil, nil); nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+
+	// The first Page.reload fails.
+	err := soc.Call(context.Background(), "Page.reload", nil, nil)
+	var protocolErr *ProtocolError
+	if !errors.As(err, &protocolErr) || "Page.reload" != protocolErr.Method || !errors.Is(err, ErrServerError) {
+		t.Errorf("Expected a Page.reload ProtocolError, got %v", err)
+	}
+
+	var invalid int
+	err = soc.Call(context.Background(), "Browser.newMethod", map[string]string{"key": "value"}, &invalid)
+	if !codes.Is(err, codes.SocketResultInvalid) {
+		t.Errorf("Expected a SocketResultInvalid error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = soc.Call(ctx, "Browser.newMethod", nil, nil)
+	if !codes.Is(err, codes.SocketCommandCancelled) || !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected a SocketCommandCancelled error, got %v", err)
+	}
+}
+
+func TestSessionCall(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSessionCall")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+
+	result := map[string]int{}
+	if err := soc.Session("session-1").Call(context.Background(), "Page.newMethod", map[string]int{"count": 2}, &result); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if 2 != result["count"] {
+		t.Errorf("Expected 2, got %v", result)
+	}
+	if "session-1" != conn.payloads[0].SessionID {
+		t.Errorf("Expected the command to be sent to 'session-1', got '%s'", conn.payloads[0].SessionID)
+	}
+}
@@ -2,9 +2,11 @@ package socket
 
 import (
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
-	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
 )
 
 /*
@@ -15,6 +17,7 @@ func NewCommandMap() *CommandMap {
 	return &CommandMap{
 		stack: make(map[int]Commander),
 		mux:   &sync.Mutex{},
+		sent:  make(map[int]time.Time),
 	}
 }
 
@@ -25,6 +28,7 @@ command stack.
 type CommandMap struct {
 	mux   *sync.Mutex
 	stack map[int]Commander
+	sent  map[int]time.Time
 }
 
 /*
@@ -35,6 +39,7 @@ Delete is a CommandMapper implementation.
 func (stack *CommandMap) Delete(id int) {
 	stack.mux.Lock()
 	delete(stack.stack, id)
+	delete(stack.sent, id)
 	stack.mux.Unlock()
 }
 
@@ -48,7 +53,49 @@ func (stack *CommandMap) Get(id int) (Commander, error) {
 	command, ok := stack.stack[id]
 	stack.mux.Unlock()
 	if !ok {
-		return nil, errs.New(0, fmt.Sprintf("Command %d not found", id))
+		return nil, codes.New(0, fmt.Sprintf("Command %d not found", id))
+	}
+	return command, nil
+}
+
+/*
+Pending returns a description of every command in the stack, ordered by command
+ID.
+
+Pending is a CommandMapper implementation.
+*/
+func (stack *CommandMap) Pending() []*PendingCommand {
+	stack.mux.Lock()
+	pending := make([]*PendingCommand, 0, len(stack.stack))
+	for id, command := range stack.stack {
+		pending = append(pending, &PendingCommand{
+			ID:     id,
+			Method: command.Method(),
+			Sent:   stack.sent[id],
+		})
+	}
+	stack.mux.Unlock()
+
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].ID < pending[j].ID
+	})
+	return pending
+}
+
+/*
+Pop retrieves and removes a command from the stack. Only one caller can pop a
+given command, which guarantees that a command is responded to at most once.
+
+Pop is a CommandMapper implementation.
+*/
+func (stack *CommandMap) Pop(id int) (Commander, error) {
+	stack.mux.Lock()
+	command, ok := stack.stack[id]
+	delete(stack.stack, id)
+	delete(stack.sent, id)
+	stack.mux.Unlock()
+	if !ok {
+		return nil, codes.New(0, fmt.Sprintf("Command %d not found", id))
 	}
 	return command, nil
 }
@@ -61,5 +108,6 @@ Set is a CommandMapper implementation.
 func (stack *CommandMap) Set(cmd Commander) {
 	stack.mux.Lock()
 	stack.stack[cmd.ID()] = cmd
+	stack.sent[cmd.ID()] = time.Now()
 	stack.mux.Unlock()
 }
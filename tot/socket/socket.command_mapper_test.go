@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"net/url"
 	"testing"
 )
 
@@ -11,3 +12,35 @@ func TestSocketCommandMapperError(t *testing.T) {
 		t.Errorf("Expected nil, got error: '%s'", err.Error())
 	}
 }
+
+func TestSocketCommandMapperPending(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketCommandMapperPending")
+	mockSocket := NewMock(socketURL)
+	commandMap := NewCommandMap()
+
+	command1 := NewCommand(mockSocket, "Some.method1", nil)
+	command2 := NewCommand(mockSocket, "Some.method2", nil)
+	commandMap.Set(command2)
+	commandMap.Set(command1)
+
+	pending := commandMap.Pending()
+	if 2 != len(pending) {
+		t.Fatalf("Expected 2 pending commands, got %d", len(pending))
+	}
+	if command1.ID() != pending[0].ID || "Some.method1" != pending[0].Method {
+		t.Errorf("Expected command #%d first, got #%d", command1.ID(), pending[0].ID)
+	}
+	if pending[0].Sent.IsZero() {
+		t.Errorf("Expected a sent time, got zero value")
+	}
+
+	if _, err := commandMap.Pop(command1.ID()); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+	if _, err := commandMap.Pop(command1.ID()); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+	if 1 != len(commandMap.Pending()) {
+		t.Errorf("Expected 1 pending command, got %d", len(commandMap.Pending()))
+	}
+}
@@ -1,5 +1,7 @@
 package socket
 
+import "sync"
+
 /*
 NewCommand creates and returns a pointer to a struct that implements the
 Commander interface.
@@ -9,7 +11,7 @@ func NewCommand(socket Socketer, method string, params interface{}) *Command {
 		id:       socket.NextCommandID(),
 		method:   method,
 		params:   params,
-		response: make(chan *Response),
+		response: make(chan *Response, 1),
 		socket:   socket,
 	}
 }
@@ -19,7 +21,8 @@ Command provides a Commander interface for sending commands to a websocket.
 */
 type Command struct {
 	// err contains any error resulting from executing the command.
-	err error
+	err    error
+	errMux sync.Mutex
 
 	// id contains the command ID.
 	id int
@@ -32,7 +35,8 @@ type Command struct {
 	params interface{}
 
 	// Optional. result holds the JSON results returned by the socket when the
-	// command is complete.
+	// command is complete. It is buffered so a late response to an abandoned
+	// command never blocks the socket read loop.
 	response chan *Response
 
 	// socket contains the Socketer instance
@@ -45,6 +49,8 @@ Error returns the most recent error, if any.
 Error is a Commander implementation.
 */
 func (cmd *Command) Error() error {
+	cmd.errMux.Lock()
+	defer cmd.errMux.Unlock()
 	return cmd.err
 }
 
@@ -99,6 +105,8 @@ SetError sets the error value
 SetError is a Commander implementation.
 */
 func (cmd *Command) SetError(err error) {
+	cmd.errMux.Lock()
+	defer cmd.errMux.Unlock()
 	cmd.err = err
 }
 
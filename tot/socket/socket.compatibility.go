@@ -0,0 +1,397 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/logger"
+)
+
+/*
+compatibilityTimeout is how long the compatibility check on connect waits for
+the browser protocol schema.
+*/
+var compatibilityTimeout = 10 * time.Second
+
+/*
+Sources of the browser protocol schema used by the compatibility check.
+*/
+const (
+	// SchemaSourceJSONProtocol is the /json/protocol HTTP endpoint of the
+	// browser, which lists the domains, methods and events.
+	SchemaSourceJSONProtocol = "/json/protocol"
+
+	// SchemaSourceGetDomains is the Schema.getDomains command, which only
+	// lists the domains.
+	SchemaSourceGetDomains = "Schema.getDomains"
+)
+
+/*
+ProtocolSurface lists the protocol methods and events a socket supports, e.g.
+"Page.navigate" and "Page.loadEventFired".
+*/
+type ProtocolSurface struct {
+	Events  []string
+	Methods []string
+}
+
+/*
+CompatibilityReport describes the differences between a protocol surface and
+the protocol supported by a browser.
+
+If the browser schema was read with Schema.getDomains only domains can be
+compared: the methods and events of missing domains are reported as missing,
+and unknown methods and events aren't reported.
+*/
+type CompatibilityReport struct {
+	// Source is the source of the browser protocol schema, either
+	// SchemaSourceJSONProtocol or SchemaSourceGetDomains.
+	Source string
+
+	// MissingDomains, MissingMethods and MissingEvents list the domains,
+	// methods and events of the surface the browser doesn't support.
+	MissingDomains []string
+	MissingEvents  []string
+	MissingMethods []string
+
+	// UnknownDomains, UnknownMethods and UnknownEvents list the domains,
+	// methods and events the browser supports that aren't part of the
+	// surface.
+	UnknownDomains []string
+	UnknownEvents  []string
+	UnknownMethods []string
+}
+
+/*
+Compatible returns whether the browser supports every method and event of the
+surface.
+*/
+func (report *CompatibilityReport) Compatible() bool {
+	return 0 == len(report.MissingMethods) && 0 == len(report.MissingEvents)
+}
+
+/*
+protocolSchema is the browser protocol schema served by /json/protocol.
+*/
+type protocolSchema struct {
+	Domains []*struct {
+		Commands []*struct {
+			Name string `json:"name"`
+		} `json:"commands"`
+		Domain string `json:"domain"`
+		Events []*struct {
+			Name string `json:"name"`
+		} `json:"events"`
+	} `json:"domains"`
+}
+
+/*
+CompareSurface compares a protocol surface with the domains, methods and events
+supported by a browser. If methods and events are nil only the domains are
+compared.
+*/
+func CompareSurface(
+	surface *ProtocolSurface,
+	domains []string,
+	methods []string,
+	events []string,
+) *CompatibilityReport {
+	report := &CompatibilityReport{Source: SchemaSourceJSONProtocol}
+	domainsOnly := nil == methods && nil == events
+	if domainsOnly {
+		report.Source = SchemaSourceGetDomains
+	}
+
+	browserDomains := nameSet(domains)
+	surfaceDomains := map[string]bool{}
+	missingDomains := map[string]bool{}
+	missing := func(names []string, browser map[string]bool) []string {
+		result := []string{}
+		for _, name := range names {
+			domain := strings.SplitN(name, ".", 2)[0]
+			surfaceDomains[domain] = true
+			if !browserDomains[domain] {
+				missingDomains[domain] = true
+				result = append(result, name)
+			} else if !domainsOnly && !browser[name] {
+				result = append(result, name)
+			}
+		}
+		return sortedNames(result)
+	}
+	report.MissingMethods = missing(surface.Methods, nameSet(methods))
+	report.MissingEvents = missing(surface.Events, nameSet(events))
+	for domain := range missingDomains {
+		report.MissingDomains = append(report.MissingDomains, domain)
+	}
+	report.MissingDomains = sortedNames(report.MissingDomains)
+
+	unknown := func(names []string, surface map[string]bool) []string {
+		result := []string{}
+		for _, name := range names {
+			if !surface[name] {
+				result = append(result, name)
+			}
+		}
+		return sortedNames(result)
+	}
+	report.UnknownDomains = unknown(domains, surfaceDomains)
+	report.UnknownMethods = unknown(methods, nameSet(surface.Methods))
+	report.UnknownEvents = unknown(events, nameSet(surface.Events))
+
+	return report
+}
+
+/*
+nameSet returns a lookup table of names.
+*/
+func nameSet(names []string) map[string]bool {
+	lookup := make(map[string]bool, len(names))
+	for _, name := range names {
+		lookup[name] = true
+	}
+	return lookup
+}
+
+/*
+sortedNames sorts names and returns them, an empty list is returned as nil.
+*/
+func sortedNames(names []string) []string {
+	if 0 == len(names) {
+		return nil
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+CheckCompatibility compares Surface with the protocol supported by the
+connected browser. The browser schema is read from the /json/protocol endpoint
+of the browser and, if that fails, with the Schema.getDomains command. A
+codes.SocketProtocolSchemaFailed error is returned if neither succeeds.
+*/
+func (socket *Socket) CheckCompatibility(ctx context.Context) (*CompatibilityReport, error) {
+	domains, methods, events, err := socket.browserProtocol(ctx)
+	if nil != err {
+		return nil, err
+	}
+	return CompareSurface(Surface, domains, methods, events), nil
+}
+
+/*
+browserProtocol returns the domains, methods and events supported by the
+connected browser, see CheckCompatibility. Methods and events are nil if only
+the domains could be retrieved.
+*/
+func (socket *Socket) browserProtocol(ctx context.Context) (domains, methods, events []string, err error) {
+	schema, err := fetchProtocolSchema(ctx, socket.url)
+	if nil == err {
+		methods = []string{}
+		events = []string{}
+		for _, domain := range schema.Domains {
+			domains = append(domains, domain.Domain)
+			for _, command := range domain.Commands {
+				methods = append(methods, domain.Domain+"."+command.Name)
+			}
+			for _, event := range domain.Events {
+				events = append(events, domain.Domain+"."+event.Name)
+			}
+		}
+		return domains, methods, events, nil
+	}
+	socket.logger.Debug("could not fetch the protocol schema", logger.Fields{"error": err, "socketID": socket.socketID})
+
+	result := <-socket.Schema().GetDomainsContext(ctx)
+	if nil != result.Err {
+		return nil, nil, nil, codes.Wrap(result.Err, codes.SocketProtocolSchemaFailed, "could not retrieve the browser protocol schema")
+	}
+	for _, domain := range result.Domains {
+		domains = append(domains, domain.Name)
+	}
+	return domains, nil, nil, nil
+}
+
+/*
+fetchProtocolSchema reads the protocol schema from the /json/protocol endpoint
+of the browser serving socketURL.
+*/
+func fetchProtocolSchema(ctx context.Context, socketURL *url.URL) (*protocolSchema, error) {
+	schemaURL := &url.URL{Scheme: "http", Host: socketURL.Host, Path: SchemaSourceJSONProtocol}
+	if "wss" == socketURL.Scheme || "https" == socketURL.Scheme {
+		schemaURL.Scheme = "https"
+	}
+
+	request, err := http.NewRequest("GET", schemaURL.String(), nil)
+	if nil != err {
+		return nil, codes.Wrap(err, codes.SocketProtocolSchemaFailed, fmt.Sprintf("invalid protocol schema URL '%s'", schemaURL))
+	}
+	response, err := http.DefaultClient.Do(request.WithContext(ctx))
+	if nil != err {
+		return nil, codes.Wrap(err, codes.SocketProtocolSchemaFailed, fmt.Sprintf("could not fetch '%s'", schemaURL))
+	}
+	defer response.Body.Close()
+	if http.StatusOK != response.StatusCode {
+		return nil, codes.New(codes.SocketProtocolSchemaFailed, fmt.Sprintf("could not fetch '%s': %s", schemaURL, response.Status))
+	}
+
+	schema := &protocolSchema{}
+	if err := json.NewDecoder(response.Body).Decode(schema); nil != err {
+		return nil, codes.Wrap(err, codes.SocketProtocolSchemaFailed, fmt.Sprintf("invalid protocol schema from '%s'", schemaURL))
+	}
+	return schema, nil
+}
+
+/*
+compatibilityCheck is the state of the compatibility check on connect.
+*/
+type compatibilityCheck struct {
+	done   chan struct{}
+	err    error
+	once   sync.Once
+	report *CompatibilityReport
+	strict bool
+}
+
+/*
+WithCompatibilityCheck checks the compatibility of the browser with Surface
+when the socket first connects, see CheckCompatibility. Missing methods and
+events are logged as a warning and the report is available from Compatibility.
+The check isn't repeated when the socket reconnects.
+
+In strict mode commands are held until the check completes. If the browser is
+missing any method or event of StableSurface, or its schema can't be retrieved,
+the socket is stopped and commands fail with a codes.SocketProtocolIncompatible
+error. Experimental and deprecated methods and events are only reported, they
+come and go between browser versions.
+*/
+func WithCompatibilityCheck(strict bool) Option {
+	return func(socket *Socket) {
+		socket.compatibility = &compatibilityCheck{
+			done:   make(chan struct{}),
+			strict: strict,
+		}
+	}
+}
+
+/*
+holdCommand holds a command until a strict compatibility check completes and
+then sends it, or fails it if the browser is incompatible or ctx is done first.
+It returns false if the command isn't held. The Schema.getDomains command of the
+check itself is never held.
+*/
+func (socket *Socket) holdCommand(ctx context.Context, command Commander, sessionID string) bool {
+	check := socket.compatibility
+	if nil == check || !check.strict || SchemaSourceGetDomains == command.Method() {
+		return false
+	}
+
+	select {
+	case <-check.done:
+		if nil == check.err {
+			return false
+		}
+		socket.failCommand(command, codes.SocketProtocolIncompatible, codes.Wrap(
+			check.err,
+			codes.SocketProtocolIncompatible,
+			fmt.Sprintf("command #%d '%s' refused", command.ID(), command.Method()),
+		))
+		return true
+	default:
+	}
+
+	go func() {
+		select {
+		case <-check.done:
+			socket.sendCommand(ctx, command, sessionID)
+		case <-ctx.Done():
+			socket.failCommand(command, codes.SocketCommandCancelled, codes.Wrap(
+				ctx.Err(),
+				codes.SocketCommandCancelled,
+				fmt.Sprintf("command #%d '%s' cancelled waiting for the compatibility check", command.ID(), command.Method()),
+			))
+		}
+	}()
+	return true
+}
+
+/*
+Compatibility waits for the compatibility check enabled by
+WithCompatibilityCheck and returns its report, or the error that prevented the
+check or, in strict mode, the incompatibility error. Nil is returned if no check
+is enabled or the socket is stopped before the check completes.
+*/
+func (socket *Socket) Compatibility() (*CompatibilityReport, error) {
+	if nil == socket.compatibility {
+		return nil, nil
+	}
+	select {
+	case <-socket.compatibility.done:
+	case <-socket.ctx.Done():
+		// A strict check that fails stops the socket, prefer its result.
+		select {
+		case <-socket.compatibility.done:
+		default:
+			return nil, nil
+		}
+	}
+	return socket.compatibility.report, socket.compatibility.err
+}
+
+/*
+checkCompatibility runs the compatibility check when the socket first connects.
+In strict mode the socket is stopped if the browser doesn't support the stable
+surface.
+*/
+func (socket *Socket) checkCompatibility() {
+	check := socket.compatibility
+	check.once.Do(func() { socket.runCompatibilityCheck(check) })
+}
+
+/*
+runCompatibilityCheck runs the compatibility check and stores its result.
+*/
+func (socket *Socket) runCompatibilityCheck(check *compatibilityCheck) {
+	ctx, cancel := context.WithTimeout(socket.ctx, compatibilityTimeout)
+	defer cancel()
+
+	var report *CompatibilityReport
+	domains, methods, events, err := socket.browserProtocol(ctx)
+	if nil != err {
+		socket.logger.Warn("protocol compatibility check failed", logger.Fields{"error": err, "socketID": socket.socketID})
+		if check.strict {
+			err = codes.Wrap(err, codes.SocketProtocolIncompatible, "browser protocol compatibility could not be verified")
+		}
+	} else if report = CompareSurface(Surface, domains, methods, events); !report.Compatible() {
+		socket.logger.Warn("browser protocol is incompatible", logger.Fields{
+			"missingEvents":  report.MissingEvents,
+			"missingMethods": report.MissingMethods,
+			"socketID":       socket.socketID,
+			"source":         report.Source,
+		})
+		if stable := CompareSurface(StableSurface, domains, methods, events); check.strict && !stable.Compatible() {
+			err = codes.New(codes.SocketProtocolIncompatible, fmt.Sprintf(
+				"browser is missing %d stable protocol methods and %d events",
+				len(stable.MissingMethods),
+				len(stable.MissingEvents),
+			))
+		}
+	}
+
+	check.report = report
+	check.err = err
+	close(check.done)
+
+	if check.strict && nil != err {
+		socket.logger.Error("refusing incompatible browser", logger.Fields{"error": err, "socketID": socket.socketID})
+		socket.emitConnectionState(&ConnectionStateEvent{State: StateGaveUp, Err: err})
+		socket.Stop()
+	}
+}
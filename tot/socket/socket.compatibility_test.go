@@ -0,0 +1,282 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+newSchemaServer returns a server for the /json/protocol schema listing Surface
+without the dropped methods and events and with the added ones.
+*/
+func newSchemaServer(drop []string, add []string) *httptest.Server {
+	dropped := nameSet(drop)
+	domains := map[string]map[string][]map[string]string{}
+	list := func(names []string, kind string) {
+		for _, name := range names {
+			if dropped[name] {
+				continue
+			}
+			parts := strings.SplitN(name, ".", 2)
+			if _, ok := domains[parts[0]]; !ok {
+				domains[parts[0]] = map[string][]map[string]string{"commands": {}, "events": {}}
+			}
+			domains[parts[0]][kind] = append(domains[parts[0]][kind], map[string]string{"name": parts[1]})
+		}
+	}
+	list(Surface.Methods, "commands")
+	list(Surface.Events, "events")
+	list(add, "commands")
+
+	schema := map[string][]map[string]interface{}{"domains": {}}
+	for domain, members := range domains {
+		schema["domains"] = append(schema["domains"], map[string]interface{}{
+			"commands": members["commands"],
+			"domain":   domain,
+			"events":   members["events"],
+		})
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if SchemaSourceJSONProtocol != r.URL.Path {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(schema)
+	}))
+}
+
+func TestCompareSurface(t *testing.T) {
+	surface := &ProtocolSurface{
+		Events:  []string{"Network.dataReceived", "Page.loadEventFired"},
+		Methods: []string{"Network.enable", "Page.enable", "Page.navigate"},
+	}
+
+	report := CompareSurface(
+		surface,
+		[]string{"Page", "Storage"},
+		[]string{"Page.enable", "Page.reload", "Storage.clearCookies"},
+		[]string{"Page.loadEventFired"},
+	)
+	expected := &CompatibilityReport{
+		Source:         SchemaSourceJSONProtocol,
+		MissingDomains: []string{"Network"},
+		MissingEvents:  []string{"Network.dataReceived"},
+		MissingMethods: []string{"Network.enable", "Page.navigate"},
+		UnknownDomains: []string{"Storage"},
+		UnknownMethods: []string{"Page.reload", "Storage.clearCookies"},
+	}
+	if !reflect.DeepEqual(expected, report) {
+		t.Errorf("Expected %+v, got %+v", expected, report)
+	}
+	if report.Compatible() {
+		t.Errorf("Expected an incompatible report")
+	}
+
+	report = CompareSurface(surface, []string{"Network", "Page"}, nil, nil)
+	expected = &CompatibilityReport{Source: SchemaSourceGetDomains}
+	if !reflect.DeepEqual(expected, report) || !report.Compatible() {
+		t.Errorf("Expected %+v, got %+v", expected, report)
+	}
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	server := newSchemaServer([]string{"Page.navigate", "Page.loadEventFired"}, []string{"Page.newMethod"})
+	defer server.Close()
+
+	socketURL, _ := url.Parse(strings.Replace(server.URL, "http", "ws", 1) + "/devtools/browser/1")
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return newMiddlewareWebSocket(), nil
+	}))
+	defer soc.Stop()
+
+	report, err := soc.CheckCompatibility(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if SchemaSourceJSONProtocol != report.Source {
+		t.Errorf("Expected source '%s', got '%s'", SchemaSourceJSONProtocol, report.Source)
+	}
+	if !reflect.DeepEqual([]string{"Page.navigate"}, report.MissingMethods) {
+		t.Errorf("Expected Page.navigate to be missing, got %v", report.MissingMethods)
+	}
+	if !reflect.DeepEqual([]string{"Page.loadEventFired"}, report.MissingEvents) {
+		t.Errorf("Expected Page.loadEventFired to be missing, got %v", report.MissingEvents)
+	}
+	if !reflect.DeepEqual([]string{"Page.newMethod"}, report.UnknownMethods) {
+		t.Errorf("Expected Page.newMethod to be unknown, got %v", report.UnknownMethods)
+	}
+}
+
+func TestCheckCompatibilityProtocol(t *testing.T) {
+	schema := &protocolSchema{}
+	for _, file := range []string{"../protocol/browser_protocol.json", "../protocol/js_protocol.json"} {
+		data, err := ioutil.ReadFile(file)
+		if nil != err {
+			t.Fatalf("Expected nil, got error: %v", err)
+		}
+		definition := &protocolSchema{}
+		if err := json.Unmarshal(data, definition); nil != err {
+			t.Fatalf("Expected nil, got error: %v", err)
+		}
+		schema.Domains = append(schema.Domains, definition.Domains...)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(schema)
+	}))
+	defer server.Close()
+
+	socketURL, _ := url.Parse(strings.Replace(server.URL, "http", "ws", 1) + "/devtools/browser/1")
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return newMiddlewareWebSocket(), nil
+	}))
+	defer soc.Stop()
+
+	report, err := soc.CheckCompatibility(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if !report.Compatible() || nil != report.UnknownMethods || nil != report.UnknownEvents {
+		t.Errorf("Expected the protocol definitions to match Surface, got %+v", report)
+	}
+}
+
+func TestCheckCompatibilityGetDomains(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	socketURL, _ := url.Parse(strings.Replace(server.URL, "http", "ws", 1) + "/devtools/browser/1")
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return newMiddlewareWebSocket(), nil
+	}))
+	defer soc.Stop()
+	soc.Use(func(next Sender) Sender {
+		return func(ctx context.Context, payload *Payload) *Response {
+			if SchemaSourceGetDomains == payload.Method {
+				return &Response{ID: payload.ID, Result: []byte(`{"domains":[{"name":"Page","version":"1.3"}]}`)}
+			}
+			return next(ctx, payload)
+		}
+	})
+
+	report, err := soc.CheckCompatibility(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if SchemaSourceGetDomains != report.Source {
+		t.Errorf("Expected source '%s', got '%s'", SchemaSourceGetDomains, report.Source)
+	}
+	missing := nameSet(report.MissingMethods)
+	if !missing["Network.enable"] || missing["Page.navigate"] {
+		t.Errorf("Expected the Network methods to be missing, got %v", report.MissingMethods)
+	}
+	if !nameSet(report.MissingDomains)["Network"] || nil != report.UnknownMethods {
+		t.Errorf("Expected the Network domain to be missing, got %+v", report)
+	}
+}
+
+func TestWithCompatibilityCheck(t *testing.T) {
+	server := newSchemaServer([]string{"Page.navigate"}, nil)
+	defer server.Close()
+	socketURL, _ := url.Parse(strings.Replace(server.URL, "http", "ws", 1) + "/devtools/browser/1")
+
+	soc := New(socketURL,
+		WithWebSocket(func(*url.URL) (WebSocketer, error) {
+			return newMiddlewareWebSocket(), nil
+		}),
+		WithCompatibilityCheck(false),
+	)
+	defer soc.Stop()
+
+	if err := soc.Call(context.Background(), "Page.enable", nil, nil); nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+	report, err := soc.Compatibility()
+	if nil != err || nil == report || !reflect.DeepEqual([]string{"Page.navigate"}, report.MissingMethods) {
+		t.Errorf("Expected Page.navigate to be missing, got %+v, %v", report, err)
+	}
+}
+
+func TestWithCompatibilityCheckStrict(t *testing.T) {
+	server := newSchemaServer([]string{"Page.navigate"}, nil)
+	defer server.Close()
+	socketURL, _ := url.Parse(strings.Replace(server.URL, "http", "ws", 1) + "/devtools/browser/1")
+
+	soc := New(socketURL,
+		WithWebSocket(func(*url.URL) (WebSocketer, error) {
+			return newMiddlewareWebSocket(), nil
+		}),
+		WithCompatibilityCheck(true),
+	)
+	defer soc.Stop()
+
+	err := soc.Call(context.Background(), "Page.enable", nil, nil)
+	var protocolErr *ProtocolError
+	if errors.As(err, &protocolErr) || !codes.Is(err, codes.SocketProtocolIncompatible) {
+		t.Errorf("Expected a SocketProtocolIncompatible error, got %v", err)
+	}
+	result := <-soc.Page().Enable(nil)
+	if errors.As(result.Err, &protocolErr) || !codes.Is(result.Err, codes.SocketProtocolIncompatible) {
+		t.Errorf("Expected a SocketProtocolIncompatible error, got %v", result.Err)
+	}
+	if _, err := soc.Compatibility(); !codes.Is(err, codes.SocketProtocolIncompatible) {
+		t.Errorf("Expected a SocketProtocolIncompatible error, got %v", err)
+	}
+
+	select {
+	case <-soc.Done():
+	case <-time.After(time.Second):
+		t.Errorf("Expected the socket to be stopped")
+	}
+}
+
+func TestWithCompatibilityCheckStrictExperimental(t *testing.T) {
+	// Browsers add and remove experimental methods between versions, a
+	// strict check only requires the stable surface.
+	stable := nameSet(StableSurface.Methods)
+	experimental := ""
+	for _, method := range Surface.Methods {
+		if !stable[method] {
+			experimental = method
+			break
+		}
+	}
+	if "" == experimental {
+		t.Fatalf("Expected Surface to contain experimental methods")
+	}
+	server := newSchemaServer([]string{experimental}, nil)
+	defer server.Close()
+	socketURL, _ := url.Parse(strings.Replace(server.URL, "http", "ws", 1) + "/devtools/browser/1")
+
+	soc := New(socketURL,
+		WithWebSocket(func(*url.URL) (WebSocketer, error) {
+			return newMiddlewareWebSocket(), nil
+		}),
+		WithCompatibilityCheck(true),
+	)
+	defer soc.Stop()
+
+	if err := soc.Call(context.Background(), "Page.enable", nil, nil); nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+	report, err := soc.Compatibility()
+	if nil != err || nil == report || !reflect.DeepEqual([]string{experimental}, report.MissingMethods) {
+		t.Errorf("Expected %s to be reported missing, got %+v, %v", experimental, report, err)
+	}
+
+	// The check runs once, e.g. not again after a reconnect.
+	soc.checkCompatibility()
+	if _, err := soc.Compatibility(); nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+}
@@ -3,19 +3,21 @@ package socket
 import (
 	"fmt"
 
-	errs "github.com/bdlm/errors"
-	"github.com/bdlm/log"
 	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/logger"
 )
 
 /*
-Conn returns the current web socket pointer.
+Conn returns the current web socket pointer. The first connection is established
+on demand. Once it has been established Conn no longer dials and returns nil
+after the connection was closed or lost, until it is re-established by Connect
+or the reconnect policy.
 
 Conn is a Conner implementation.
 */
 func (socket *Socket) Conn() WebSocketer {
-	socket.Connect()
-	return socket.conn
+	conn, _ := socket.connection()
+	return conn
 }
 
 /*
@@ -31,16 +33,15 @@ func (socket *Socket) Connect() error {
 		return nil
 	}
 
-	log.WithFields(log.Fields{"socketID": socket.socketID, "url": socket.url.String()}).
-		Debug("connecting")
+	socket.logger.Debug("connecting", logger.Fields{"socketID": socket.socketID, "url": socket.url.String()})
 	websocket, err := socket.newSocket(socket.url)
 	if nil != err {
-		return errs.Wrap(err, codes.SocketEventHandlerNotFound, "Connect() failed while creating socket")
+		return codes.Wrap(err, codes.SocketEventHandlerNotFound, "Connect() failed while creating socket")
 	}
 
 	socket.conn = websocket
-	log.WithFields(log.Fields{"socketID": socket.socketID, "url": socket.url.String()}).
-		Debug("connection established")
+	socket.dialed = true
+	socket.logger.Debug("connection established", logger.Fields{"socketID": socket.socketID, "url": socket.url.String()})
 
 	return nil
 }
@@ -51,21 +52,55 @@ Connected returns whether a connection exists.
 Connected is a Conner implementation.
 */
 func (socket *Socket) Connected() bool {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
 	return nil != socket.conn
 }
 
+/*
+connection returns the current websocket connection. The first connection is
+established on demand. Once it has been established a lost connection is only
+re-dialed by the reconnect policy and a codes.SocketNotConnected error is
+returned until then.
+*/
+func (socket *Socket) connection() (WebSocketer, error) {
+	socket.mux.Lock()
+	conn := socket.conn
+	dialed := socket.dialed
+	socket.mux.Unlock()
+	if nil != conn {
+		return conn, nil
+	}
+	if dialed {
+		return nil, codes.New(codes.SocketNotConnected, "not connected")
+	}
+
+	if err := socket.Connect(); nil != err {
+		return nil, codes.Wrap(err, codes.SocketNotConnected, "not connected")
+	}
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	if nil == socket.conn {
+		return nil, codes.New(codes.SocketNotConnected, "not connected")
+	}
+	return socket.conn, nil
+}
+
 /*
 Disconnect closes a websocket connection.
 
 Disconnect is a Conner implementation.
 */
 func (socket *Socket) Disconnect() error {
-	if !socket.Connected() {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+
+	if nil == socket.conn {
 		return fmt.Errorf("not connected")
 	}
 	err := socket.conn.Close()
 	if nil != err {
-		err = errs.Wrap(err, codes.SocketCloseFailed, "could not close socket connection")
+		err = codes.Wrap(err, codes.SocketCloseFailed, "could not close socket connection")
 	}
 	socket.conn = nil
 	socket.connected = false
@@ -73,17 +108,18 @@ func (socket *Socket) Disconnect() error {
 }
 
 /*
-ReadJSON reads data from a websocket connection.
+ReadJSON reads data from a websocket connection. A codes.SocketNotConnected
+error is returned while a lost connection hasn't been re-established.
 
 ReadJSON is a Conner implementation.
 */
 func (socket *Socket) ReadJSON(v interface{}) error {
-	err := socket.Connect()
+	conn, err := socket.connection()
 	if nil != err {
-		return errs.Wrap(err, codes.SocketNotConnected, "not connected")
+		return err
 	}
 
-	err = socket.conn.ReadJSON(&v)
+	err = conn.ReadJSON(&v)
 	if nil != err {
 		return err
 	}
@@ -92,19 +128,20 @@ func (socket *Socket) ReadJSON(v interface{}) error {
 }
 
 /*
-WriteJSON writes data to a websocket connection.
+WriteJSON writes data to a websocket connection. A codes.SocketNotConnected
+error is returned while a lost connection hasn't been re-established.
 
 WriteJSON is a Conner implementation.
 */
 func (socket *Socket) WriteJSON(v interface{}) error {
-	err := socket.Connect()
+	conn, err := socket.connection()
 	if nil != err {
-		return errs.Wrap(err, codes.SocketNotConnected, "not connected")
+		return err
 	}
 
-	err = socket.conn.WriteJSON(v)
+	err = conn.WriteJSON(v)
 	if nil != err {
-		return errs.Wrap(err, codes.SocketWriteFailed, "socket write failed")
+		return codes.Wrap(err, codes.SocketWriteFailed, "socket write failed")
 	}
 
 	return nil
@@ -3,6 +3,8 @@ package socket
 import (
 	"net/url"
 	"testing"
+
+	"github.com/mkenney/go-chrome/codes"
 )
 
 func TestConner(t *testing.T) {
@@ -24,3 +26,49 @@ func TestConner(t *testing.T) {
 		t.Errorf("Expected nil, got error: '%s'", err.Error())
 	}
 }
+
+func TestConnerDisconnected(t *testing.T) {
+	socketURL, _ := url.Parse("http://test:9222/TestConnerDisconnected")
+	socket := NewMock(socketURL)
+	dials := 0
+	socket.newSocket = func(socketURL *url.URL) (WebSocketer, error) {
+		dials++
+		return NewMockWebsocket(socketURL)
+	}
+
+	if err := socket.WriteJSON(&Payload{ID: 1, Method: "Page.enable"}); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+	if err := socket.Disconnect(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	err := socket.WriteJSON(&Payload{ID: 2, Method: "Page.enable"})
+	if !codes.Is(err, codes.SocketNotConnected) {
+		t.Errorf("Expected a codes.SocketNotConnected error, got '%v'", err)
+	}
+	if 1 != dials {
+		t.Errorf("Expected 1 dial, got %d", dials)
+	}
+}
+
+func TestConnerConn(t *testing.T) {
+	socketURL, _ := url.Parse("http://test:9222/TestConnerConn")
+	socket := NewMock(socketURL)
+
+	if nil == socket.Conn() {
+		t.Fatalf("Expected the first connection to be established on demand")
+	}
+	if err := socket.Disconnect(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+	if conn := socket.Conn(); nil != conn {
+		t.Errorf("Expected nil after the connection was closed, got %v", conn)
+	}
+	if err := socket.Connect(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+	if nil == socket.Conn() {
+		t.Errorf("Expected the connection after Connect")
+	}
+}
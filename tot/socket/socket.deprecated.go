@@ -0,0 +1,256 @@
+package socket
+
+import (
+	"context"
+
+	"github.com/mkenney/go-chrome/tot/device/orientation"
+	"github.com/mkenney/go-chrome/tot/dom/snapshot"
+	"github.com/mkenney/go-chrome/tot/dom/storage"
+	"github.com/mkenney/go-chrome/tot/input"
+	"github.com/mkenney/go-chrome/tot/network"
+)
+
+/*
+ClearOverride is the former name of ClearDeviceOrientationOverride.
+
+Deprecated: use ClearDeviceOrientationOverride.
+*/
+func (protocol *DeviceOrientationProtocol) ClearOverride() <-chan *orientation.ClearDeviceOrientationOverrideResult {
+	return protocol.ClearDeviceOrientationOverride()
+}
+
+/*
+ClearOverrideContext is the former name of ClearDeviceOrientationOverrideContext.
+
+Deprecated: use ClearDeviceOrientationOverrideContext.
+*/
+func (protocol *DeviceOrientationProtocol) ClearOverrideContext(
+	ctx context.Context,
+) <-chan *orientation.ClearDeviceOrientationOverrideResult {
+	return protocol.ClearDeviceOrientationOverrideContext(ctx)
+}
+
+/*
+SetOverride is the former name of SetDeviceOrientationOverride.
+
+Deprecated: use SetDeviceOrientationOverride.
+*/
+func (protocol *DeviceOrientationProtocol) SetOverride(
+	params *orientation.SetDeviceOrientationOverrideParams,
+) <-chan *orientation.SetDeviceOrientationOverrideResult {
+	return protocol.SetDeviceOrientationOverride(params)
+}
+
+/*
+SetOverrideContext is the former name of SetDeviceOrientationOverrideContext.
+
+Deprecated: use SetDeviceOrientationOverrideContext.
+*/
+func (protocol *DeviceOrientationProtocol) SetOverrideContext(
+	ctx context.Context,
+	params *orientation.SetDeviceOrientationOverrideParams,
+) <-chan *orientation.SetDeviceOrientationOverrideResult {
+	return protocol.SetDeviceOrientationOverrideContext(ctx, params)
+}
+
+/*
+Get is the former name of GetSnapshot.
+
+Deprecated: use GetSnapshot.
+*/
+func (protocol *DOMSnapshotProtocol) Get(
+	params *snapshot.GetSnapshotParams,
+) <-chan *snapshot.GetSnapshotResult {
+	return protocol.GetSnapshot(params)
+}
+
+/*
+GetContext is the former name of GetSnapshotContext.
+
+Deprecated: use GetSnapshotContext.
+*/
+func (protocol *DOMSnapshotProtocol) GetContext(
+	ctx context.Context,
+	params *snapshot.GetSnapshotParams,
+) <-chan *snapshot.GetSnapshotResult {
+	return protocol.GetSnapshotContext(ctx, params)
+}
+
+/*
+GetItems is the former name of GetDOMStorageItems.
+
+Deprecated: use GetDOMStorageItems.
+*/
+func (protocol *DOMStorageProtocol) GetItems(
+	params *storage.GetDOMStorageItemsParams,
+) <-chan *storage.GetDOMStorageItemsResult {
+	return protocol.GetDOMStorageItems(params)
+}
+
+/*
+GetItemsContext is the former name of GetDOMStorageItemsContext.
+
+Deprecated: use GetDOMStorageItemsContext.
+*/
+func (protocol *DOMStorageProtocol) GetItemsContext(
+	ctx context.Context,
+	params *storage.GetDOMStorageItemsParams,
+) <-chan *storage.GetDOMStorageItemsResult {
+	return protocol.GetDOMStorageItemsContext(ctx, params)
+}
+
+/*
+RemoveItem is the former name of RemoveDOMStorageItem.
+
+Deprecated: use RemoveDOMStorageItem.
+*/
+func (protocol *DOMStorageProtocol) RemoveItem(
+	params *storage.RemoveDOMStorageItemParams,
+) <-chan *storage.RemoveDOMStorageItemResult {
+	return protocol.RemoveDOMStorageItem(params)
+}
+
+/*
+RemoveItemContext is the former name of RemoveDOMStorageItemContext.
+
+Deprecated: use RemoveDOMStorageItemContext.
+*/
+func (protocol *DOMStorageProtocol) RemoveItemContext(
+	ctx context.Context,
+	params *storage.RemoveDOMStorageItemParams,
+) <-chan *storage.RemoveDOMStorageItemResult {
+	return protocol.RemoveDOMStorageItemContext(ctx, params)
+}
+
+/*
+SetItem is the former name of SetDOMStorageItem.
+
+Deprecated: use SetDOMStorageItem.
+*/
+func (protocol *DOMStorageProtocol) SetItem(
+	params *storage.SetDOMStorageItemParams,
+) <-chan *storage.SetDOMStorageItemResult {
+	return protocol.SetDOMStorageItem(params)
+}
+
+/*
+SetItemContext is the former name of SetDOMStorageItemContext.
+
+Deprecated: use SetDOMStorageItemContext.
+*/
+func (protocol *DOMStorageProtocol) SetItemContext(
+	ctx context.Context,
+	params *storage.SetDOMStorageItemParams,
+) <-chan *storage.SetDOMStorageItemResult {
+	return protocol.SetDOMStorageItemContext(ctx, params)
+}
+
+/*
+SetIgnoreEvents is the former name of SetIgnoreInputEvents.
+
+Deprecated: use SetIgnoreInputEvents.
+*/
+func (protocol *InputProtocol) SetIgnoreEvents(
+	params *input.SetIgnoreInputEventsParams,
+) <-chan *input.SetIgnoreInputEventsResult {
+	return protocol.SetIgnoreInputEvents(params)
+}
+
+/*
+SetIgnoreEventsContext is the former name of SetIgnoreInputEventsContext.
+
+Deprecated: use SetIgnoreInputEventsContext.
+*/
+func (protocol *InputProtocol) SetIgnoreEventsContext(
+	ctx context.Context,
+	params *input.SetIgnoreInputEventsParams,
+) <-chan *input.SetIgnoreInputEventsResult {
+	return protocol.SetIgnoreInputEventsContext(ctx, params)
+}
+
+/*
+CanEmulateConditions is the former name of CanEmulateNetworkConditions.
+
+Deprecated: use CanEmulateNetworkConditions.
+*/
+func (protocol *NetworkProtocol) CanEmulateConditions() <-chan *network.CanEmulateNetworkConditionsResult {
+	return protocol.CanEmulateNetworkConditions()
+}
+
+/*
+CanEmulateConditionsContext is the former name of CanEmulateNetworkConditionsContext.
+
+Deprecated: use CanEmulateNetworkConditionsContext.
+*/
+func (protocol *NetworkProtocol) CanEmulateConditionsContext(
+	ctx context.Context,
+) <-chan *network.CanEmulateNetworkConditionsResult {
+	return protocol.CanEmulateNetworkConditionsContext(ctx)
+}
+
+/*
+EmulateConditions is the former name of EmulateNetworkConditions.
+
+Deprecated: use EmulateNetworkConditions.
+*/
+func (protocol *NetworkProtocol) EmulateConditions(
+	params *network.EmulateNetworkConditionsParams,
+) <-chan *network.EmulateNetworkConditionsResult {
+	return protocol.EmulateNetworkConditions(params)
+}
+
+/*
+EmulateConditionsContext is the former name of EmulateNetworkConditionsContext.
+
+Deprecated: use EmulateNetworkConditionsContext.
+*/
+func (protocol *NetworkProtocol) EmulateConditionsContext(
+	ctx context.Context,
+	params *network.EmulateNetworkConditionsParams,
+) <-chan *network.EmulateNetworkConditionsResult {
+	return protocol.EmulateNetworkConditionsContext(ctx, params)
+}
+
+/*
+OnItemAdded is the former name of OnDOMStorageItemAdded.
+
+Deprecated: use OnDOMStorageItemAdded.
+*/
+func (protocol *DOMStorageProtocol) OnItemAdded(
+	callback func(event *storage.DOMStorageItemAddedEvent),
+) *Subscription {
+	return protocol.OnDOMStorageItemAdded(callback)
+}
+
+/*
+OnItemRemoved is the former name of OnDOMStorageItemRemoved.
+
+Deprecated: use OnDOMStorageItemRemoved.
+*/
+func (protocol *DOMStorageProtocol) OnItemRemoved(
+	callback func(event *storage.DOMStorageItemRemovedEvent),
+) *Subscription {
+	return protocol.OnDOMStorageItemRemoved(callback)
+}
+
+/*
+OnItemUpdated is the former name of OnDOMStorageItemUpdated.
+
+Deprecated: use OnDOMStorageItemUpdated.
+*/
+func (protocol *DOMStorageProtocol) OnItemUpdated(
+	callback func(event *storage.DOMStorageItemUpdatedEvent),
+) *Subscription {
+	return protocol.OnDOMStorageItemUpdated(callback)
+}
+
+/*
+OnItemsCleared is the former name of OnDOMStorageItemsCleared.
+
+Deprecated: use OnDOMStorageItemsCleared.
+*/
+func (protocol *DOMStorageProtocol) OnItemsCleared(
+	callback func(event *storage.DOMStorageItemsClearedEvent),
+) *Subscription {
+	return protocol.OnDOMStorageItemsCleared(callback)
+}
@@ -0,0 +1,396 @@
+package socket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mkenney/go-chrome/logger"
+)
+
+/*
+EnabledDomain describes a protocol domain enabled on a socket or session.
+*/
+type EnabledDomain struct {
+	// Domain is the protocol domain, e.g. "Network".
+	Domain string
+
+	// Params are the parameters of the latest enable command, e.g. a
+	// *network.EnableParams, or nil.
+	Params interface{}
+
+	// Refs is the number of enable commands and DomainHandles holding the
+	// domain.
+	Refs int
+}
+
+/*
+trackedDomain counts the references to an enabled domain. Plain enable
+commands and DomainHandles are counted separately so an unbalanced plain
+disable command can't release a handle's reference.
+*/
+type trackedDomain struct {
+	enables int
+	handles int
+	params  interface{}
+}
+
+/*
+domainTracker tracks the protocol domains enabled on a socket or session.
+*/
+type domainTracker struct {
+	domains map[string]*trackedDomain
+	mux     *sync.Mutex
+}
+
+/*
+newDomainTracker returns an empty domain tracker.
+*/
+func newDomainTracker() *domainTracker {
+	return &domainTracker{
+		domains: make(map[string]*trackedDomain),
+		mux:     &sync.Mutex{},
+	}
+}
+
+/*
+track adds a reference to a domain for each successful enable command. Disable
+commands are accounted for before they are sent, see disable.
+*/
+func (tracker *domainTracker) track(command Commander, response *Response) {
+	if nil != response.Error && 0 != response.Error.Code {
+		return
+	}
+	if !strings.HasSuffix(command.Method(), ".enable") {
+		return
+	}
+
+	domain := strings.TrimSuffix(command.Method(), ".enable")
+	tracker.mux.Lock()
+	defer tracker.mux.Unlock()
+	tracked, ok := tracker.domains[domain]
+	if !ok {
+		tracked = &trackedDomain{}
+		tracker.domains[domain] = tracked
+	}
+	tracked.params = command.Params()
+	tracked.enables++
+}
+
+/*
+acquire adds a handle reference to a domain that is already enabled with the
+same params. It returns false if an enable command must be sent first, see
+hold.
+*/
+func (tracker *domainTracker) acquire(domain string, params interface{}) bool {
+	tracker.mux.Lock()
+	defer tracker.mux.Unlock()
+	tracked, ok := tracker.domains[domain]
+	if !ok || !sameParams(tracked.params, params) {
+		return false
+	}
+	tracked.handles++
+	return true
+}
+
+/*
+hold moves the reference added by a successful enable command to a handle.
+*/
+func (tracker *domainTracker) hold(domain string) {
+	tracker.mux.Lock()
+	defer tracker.mux.Unlock()
+	tracked, ok := tracker.domains[domain]
+	if !ok {
+		tracked = &trackedDomain{enables: 1}
+		tracker.domains[domain] = tracked
+	}
+	tracked.enables--
+	tracked.handles++
+}
+
+/*
+addRefs adds enable command references to a tracked domain, a negative count
+removes them. Domains that aren't tracked are ignored.
+*/
+func (tracker *domainTracker) addRefs(domain string, refs int) {
+	tracker.mux.Lock()
+	defer tracker.mux.Unlock()
+	if tracked, ok := tracker.domains[domain]; ok {
+		tracked.enables += refs
+		if tracked.enables < 0 {
+			tracked.enables = 0
+		}
+	}
+}
+
+/*
+disable removes an enable command reference to a domain. It returns true if
+the domain is still referenced and the disable command must not be sent. The
+domain is no longer tracked once the last reference is removed.
+*/
+func (tracker *domainTracker) disable(domain string) bool {
+	tracker.mux.Lock()
+	defer tracker.mux.Unlock()
+	tracked, ok := tracker.domains[domain]
+	if !ok {
+		return false
+	}
+	if tracked.enables > 0 {
+		tracked.enables--
+	}
+	if tracked.enables+tracked.handles > 0 {
+		return true
+	}
+	delete(tracker.domains, domain)
+	return false
+}
+
+/*
+release removes a handle reference to a domain. It returns true if the last
+reference was removed and the domain should be disabled, the domain is no
+longer tracked at that point so a concurrent EnableDomain enables it again.
+*/
+func (tracker *domainTracker) release(domain string) bool {
+	tracker.mux.Lock()
+	defer tracker.mux.Unlock()
+	tracked, ok := tracker.domains[domain]
+	if !ok || tracked.handles <= 0 {
+		return false
+	}
+	tracked.handles--
+	if tracked.enables+tracked.handles > 0 {
+		return false
+	}
+	delete(tracker.domains, domain)
+	return true
+}
+
+/*
+list returns the tracked domains ordered by name.
+*/
+func (tracker *domainTracker) list() []*EnabledDomain {
+	tracker.mux.Lock()
+	defer tracker.mux.Unlock()
+	domains := make([]*EnabledDomain, 0, len(tracker.domains))
+	for domain, tracked := range tracker.domains {
+		domains = append(domains, &EnabledDomain{
+			Domain: domain,
+			Params: tracked.params,
+			Refs:   tracked.enables + tracked.handles,
+		})
+	}
+	sort.Slice(domains, func(a, b int) bool {
+		return domains[a].Domain < domains[b].Domain
+	})
+	return domains
+}
+
+/*
+sameParams returns whether two enable command params marshal to the same JSON.
+Nil and empty params are the same.
+*/
+func sameParams(a, b interface{}) bool {
+	marshal := func(params interface{}) []byte {
+		data, err := json.Marshal(params)
+		if nil != err || "null" == string(data) {
+			return []byte("{}")
+		}
+		return data
+	}
+	return bytes.Equal(marshal(a), marshal(b))
+}
+
+/*
+DomainHandle is a reference to an enabled protocol domain returned by
+EnableDomain. The domain is disabled when the last handle is released.
+*/
+type DomainHandle struct {
+	domain   string
+	err      error
+	released sync.Once
+	socket   Socketer
+	tracker  *domainTracker
+}
+
+/*
+Domain returns the protocol domain, e.g. "Network".
+*/
+func (handle *DomainHandle) Domain() string {
+	return handle.domain
+}
+
+/*
+Release removes the handle's reference to the domain and sends the disable
+command if it was the last one. Releasing a handle more than once has no
+effect and returns the result of the first release.
+*/
+func (handle *DomainHandle) Release(ctx context.Context) error {
+	handle.released.Do(func() {
+		if handle.tracker.release(handle.domain) {
+			handle.err = call(ctx, handle.socket, handle.domain+".disable", nil, nil)
+		}
+	})
+	return handle.err
+}
+
+/*
+EnableDomain enables a protocol domain and returns a reference-counted handle
+to it, e.g.
+
+	network, err := soc.EnableDomain(ctx, "Network", &network.EnableParams{
+		MaxTotalBufferSize: 10000000,
+	})
+	defer network.Release(ctx)
+
+The enable command is only sent if the domain isn't enabled yet or params
+differ from those it was enabled with, in which case the latest params apply to
+every handle. The disable command is sent when the last handle is released.
+
+Plain enable and disable commands, e.g. Network().Enable() and
+Network().Disable(), are reference counted as well: a disable command is
+acknowledged without being sent while the domain is still enabled by another
+enable command or held by a handle, so one component can't break the event
+streams of another. Every enable command must be balanced by a disable
+command.
+
+Protocol errors are returned as *ProtocolError, see Call.
+
+EnableDomain is a Socketer implementation.
+*/
+func (socket *Socket) EnableDomain(
+	ctx context.Context,
+	domain string,
+	params interface{},
+) (*DomainHandle, error) {
+	return enableDomain(ctx, socket, socket.domains, domain, params)
+}
+
+/*
+EnableDomain enables a protocol domain in this session and returns a
+reference-counted handle to it, see Socket.EnableDomain.
+
+EnableDomain is a Socketer implementation.
+*/
+func (session *Session) EnableDomain(
+	ctx context.Context,
+	domain string,
+	params interface{},
+) (*DomainHandle, error) {
+	return enableDomain(ctx, session, session.domains, domain, params)
+}
+
+/*
+enableDomain enables a domain through socket and returns a handle to it.
+*/
+func enableDomain(
+	ctx context.Context,
+	socket Socketer,
+	tracker *domainTracker,
+	domain string,
+	params interface{},
+) (*DomainHandle, error) {
+	if !tracker.acquire(domain, params) {
+		if err := call(ctx, socket, domain+".enable", params, nil); nil != err {
+			return nil, err
+		}
+		tracker.hold(domain)
+	}
+	return &DomainHandle{
+		domain:  domain,
+		socket:  socket,
+		tracker: tracker,
+	}, nil
+}
+
+/*
+EnabledDomains returns the protocol domains currently enabled on the socket, by
+enable commands or DomainHandles, with their params and reference counts. The result can be passed to RestoreDomains.
+
+EnabledDomains is a Socketer implementation.
+*/
+func (socket *Socket) EnabledDomains() []*EnabledDomain {
+	return socket.domains.list()
+}
+
+/*
+RestoreDomains sends the enable commands for a set of domains, e.g. those
+returned by EnabledDomains for another session, and adopts their reference
+counts. Every domain is attempted and the first error is returned.
+
+RestoreDomains is a Socketer implementation.
+*/
+func (socket *Socket) RestoreDomains(ctx context.Context, domains []*EnabledDomain) error {
+	return restoreDomains(ctx, socket, socket.domains, domains)
+}
+
+/*
+RestoreDomains sends the enable commands for a set of domains in this session,
+see Socket.RestoreDomains.
+
+RestoreDomains is a Socketer implementation.
+*/
+func (session *Session) RestoreDomains(ctx context.Context, domains []*EnabledDomain) error {
+	return restoreDomains(ctx, session, session.domains, domains)
+}
+
+/*
+restoreDomains enables a set of domains through socket.
+*/
+func restoreDomains(
+	ctx context.Context,
+	socket Socketer,
+	tracker *domainTracker,
+	domains []*EnabledDomain,
+) error {
+	var result error
+	for _, enabled := range domains {
+		if err := call(ctx, socket, enabled.Domain+".enable", enabled.Params, nil); nil != err {
+			if nil == result {
+				result = err
+			}
+			continue
+		}
+		// The enable command added the first reference.
+		tracker.addRefs(enabled.Domain, enabled.Refs-1)
+	}
+	return result
+}
+
+/*
+domainsFor returns the domain tracker of the socket, or of an attached session
+if sessionID isn't empty. Nil is returned for unknown sessions.
+*/
+func (socket *Socket) domainsFor(sessionID string) *domainTracker {
+	if "" == sessionID {
+		return socket.domains
+	}
+	socket.sessionsMux.Lock()
+	defer socket.sessionsMux.Unlock()
+	if session, ok := socket.sessions[sessionID]; ok {
+		return session.domains
+	}
+	return nil
+}
+
+/*
+holdDisable removes a reference to the domain of a disable command and
+acknowledges the command without sending it if the domain is still referenced
+by other enable commands or DomainHandles. It returns whether the command was
+acknowledged.
+*/
+func (socket *Socket) holdDisable(command Commander, sessionID string) bool {
+	if !strings.HasSuffix(command.Method(), ".disable") {
+		return false
+	}
+	tracker := socket.domainsFor(sessionID)
+	if nil == tracker || !tracker.disable(strings.TrimSuffix(command.Method(), ".disable")) {
+		return false
+	}
+
+	socket.logger.Debug("domain is referenced, disable command not sent", logger.Fields{"commandID": command.ID(), "method": command.Method(), "sessionID": sessionID, "socketID": socket.socketID})
+	command.Respond(&Response{ID: command.ID(), Result: []byte(`{}`)})
+	return true
+}
@@ -0,0 +1,202 @@
+package socket
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/network"
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+methods returns the methods written to the connection, in order.
+*/
+func (conn *middlewareWebSocket) methods() []string {
+	conn.mux.Lock()
+	defer conn.mux.Unlock()
+	methods := []string{}
+	for _, payload := range conn.payloads {
+		methods = append(methods, payload.Method)
+	}
+	return methods
+}
+
+func TestEnableDomain(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestEnableDomain")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+	ctx := context.Background()
+
+	params := &network.EnableParams{MaxTotalBufferSize: 1000}
+	first, err := soc.EnableDomain(ctx, "Network", params)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	second, err := soc.EnableDomain(ctx, "Network", &network.EnableParams{MaxTotalBufferSize: 1000})
+	if nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	expected := []*EnabledDomain{{Domain: "Network", Params: params, Refs: 2}}
+	if domains := soc.EnabledDomains(); !reflect.DeepEqual(expected, domains) {
+		t.Errorf("Expected %+v, got %+v", expected[0], domains)
+	}
+
+	// A plain disable command can't disable a held domain.
+	if result := <-soc.Network().Disable(); nil != result.Err {
+		t.Errorf("Expected nil, got error: %v", result.Err)
+	}
+	if err := first.Release(ctx); nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+	if err := first.Release(ctx); nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+	if methods := conn.methods(); !reflect.DeepEqual([]string{"Network.enable"}, methods) {
+		t.Errorf("Expected only Network.enable to be sent, got %v", methods)
+	}
+
+	if err := second.Release(ctx); nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+	if methods := conn.methods(); !reflect.DeepEqual([]string{"Network.enable", "Network.disable"}, methods) {
+		t.Errorf("Expected Network.disable to be sent, got %v", methods)
+	}
+	if domains := soc.EnabledDomains(); 0 != len(domains) {
+		t.Errorf("Expected no enabled domains, got %+v", domains)
+	}
+}
+
+func TestEnableDomainParams(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestEnableDomainParams")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+	ctx := context.Background()
+
+	if _, err := soc.EnableDomain(ctx, "Network", nil); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if _, err := soc.EnableDomain(ctx, "Network", &network.EnableParams{}); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	params := &network.EnableParams{MaxResourceBufferSize: 1000}
+	if _, err := soc.EnableDomain(ctx, "Network", params); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+
+	if methods := conn.methods(); !reflect.DeepEqual([]string{"Network.enable", "Network.enable"}, methods) {
+		t.Errorf("Expected Network.enable to be sent again for new params, got %v", methods)
+	}
+	expected := []*EnabledDomain{{Domain: "Network", Params: params, Refs: 3}}
+	if domains := soc.EnabledDomains(); !reflect.DeepEqual(expected, domains) {
+		t.Errorf("Expected %+v, got %+v", expected[0], domains)
+	}
+}
+
+func TestEnabledDomainsCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestEnabledDomainsCommands")
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return newMiddlewareWebSocket(), nil
+	}))
+	defer soc.Stop()
+
+	if result := <-soc.Page().Enable(nil); nil != result.Err {
+		t.Fatalf("Expected nil, got error: %v", result.Err)
+	}
+	expected := []*EnabledDomain{{Domain: "Page", Params: (*page.EnableParams)(nil), Refs: 1}}
+	if domains := soc.EnabledDomains(); !reflect.DeepEqual(expected, domains) {
+		t.Errorf("Expected %+v, got %+v", expected[0], domains)
+	}
+
+	if result := <-soc.Page().Disable(); nil != result.Err {
+		t.Fatalf("Expected nil, got error: %v", result.Err)
+	}
+	if domains := soc.EnabledDomains(); 0 != len(domains) {
+		t.Errorf("Expected no enabled domains, got %+v", domains)
+	}
+}
+
+func TestEnableDomainCommandsRefcount(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestEnableDomainCommandsRefcount")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+	ctx := context.Background()
+
+	// Two components enable the domain with plain enable commands.
+	for a := 0; a < 2; a++ {
+		if result := <-soc.Network().Enable(nil); nil != result.Err {
+			t.Fatalf("Expected nil, got error: %v", result.Err)
+		}
+	}
+	handle, err := soc.EnableDomain(ctx, "Network", nil)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if domains := soc.EnabledDomains(); 1 != len(domains) || 3 != domains[0].Refs {
+		t.Errorf("Expected 3 references to Network, got %+v", domains)
+	}
+
+	// Only the last reference sends the disable command, unbalanced disable
+	// commands can't release the handle's reference.
+	for a := 0; a < 3; a++ {
+		if result := <-soc.Network().Disable(); nil != result.Err {
+			t.Fatalf("Expected nil, got error: %v", result.Err)
+		}
+	}
+	if methods := conn.methods(); !reflect.DeepEqual([]string{"Network.enable", "Network.enable"}, methods) {
+		t.Errorf("Expected Network.disable not to be sent, got %v", methods)
+	}
+	if err := handle.Release(ctx); nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+	if methods := conn.methods(); !reflect.DeepEqual([]string{"Network.enable", "Network.enable", "Network.disable"}, methods) {
+		t.Errorf("Expected Network.disable to be sent, got %v", methods)
+	}
+	if domains := soc.EnabledDomains(); 0 != len(domains) {
+		t.Errorf("Expected no enabled domains, got %+v", domains)
+	}
+}
+
+func TestSessionRestoreDomains(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSessionRestoreDomains")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+	ctx := context.Background()
+
+	first := soc.Session("first")
+	if _, err := first.EnableDomain(ctx, "Runtime", nil); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if domains := soc.EnabledDomains(); 0 != len(domains) {
+		t.Errorf("Expected no domains enabled on the browser connection, got %+v", domains)
+	}
+
+	second := soc.Session("second")
+	if err := second.RestoreDomains(ctx, first.EnabledDomains()); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	expected := []*EnabledDomain{{Domain: "Runtime", Refs: 1}}
+	if domains := second.EnabledDomains(); !reflect.DeepEqual(expected, domains) {
+		t.Errorf("Expected %+v, got %+v", expected[0], domains)
+	}
+
+	conn.mux.Lock()
+	payload := conn.payloads[len(conn.payloads)-1]
+	conn.mux.Unlock()
+	if "Runtime.enable" != payload.Method || "second" != payload.SessionID {
+		t.Errorf("Expected Runtime.enable for session 'second', got '%s' for '%s'", payload.Method, payload.SessionID)
+	}
+}
@@ -1,5 +1,9 @@
 package socket
 
+import (
+	"sync"
+)
+
 /*
 NewEventHandler returns a pointer to an event handler.
 */
@@ -9,6 +13,7 @@ func NewEventHandler(
 ) *Handler {
 	return &Handler{
 		callback: callback,
+		mux:      &sync.Mutex{},
 		name:     name,
 	}
 }
@@ -18,7 +23,9 @@ Handler provides an EventHandler interface for managing an event handler.
 */
 type Handler struct {
 	callback func(response *Response)
+	mux      *sync.Mutex
 	name     string
+	queue    *EventQueue
 }
 
 /*
@@ -40,3 +47,22 @@ Name is an EventHandler implementation.
 func (handler *Handler) Name() string {
 	return handler.name
 }
+
+/*
+Queue returns the queue the handler's events are delivered through, or nil if
+the events are dispatched by the socket.
+*/
+func (handler *Handler) Queue() *EventQueue {
+	handler.mux.Lock()
+	defer handler.mux.Unlock()
+	return handler.queue
+}
+
+/*
+SetQueue sets the queue the handler's events are delivered through.
+*/
+func (handler *Handler) SetQueue(queue *EventQueue) {
+	handler.mux.Lock()
+	handler.queue = queue
+	handler.mux.Unlock()
+}
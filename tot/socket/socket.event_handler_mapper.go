@@ -2,10 +2,9 @@ package socket
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
-	errs "github.com/bdlm/errors"
-	"github.com/bdlm/log"
 	"github.com/mkenney/go-chrome/codes"
 )
 
@@ -45,12 +44,10 @@ func (stack *EventHandlerMap) Add(
 	}
 	for _, hndl := range handlers {
 		if hndl == handler {
-			return errs.New(codes.SocketDuplicateEventHandler, fmt.Sprintf("Attempted to add a duplicate handler for event '%s'", handler.Name()))
+			return codes.New(codes.SocketDuplicateEventHandler, fmt.Sprintf("Attempted to add a duplicate handler for event '%s'", handler.Name()))
 		}
 	}
 
-	log.WithFields(log.Fields{"event": handler.Name()}).
-		Debug("Adding event handler")
 	handlers = append(handlers, handler)
 	stack.Set(handler.Name(), handlers)
 	return nil
@@ -78,7 +75,7 @@ func (stack *EventHandlerMap) Get(
 	if handlers, ok := stack.stack[name]; ok {
 		return handlers, nil
 	}
-	return nil, errs.New(codes.SocketDuplicateEventHandler, fmt.Sprintf("No event listeners found for %s", name))
+	return nil, codes.New(codes.SocketDuplicateEventHandler, fmt.Sprintf("No event listeners found for %s", name))
 }
 
 /*
@@ -90,6 +87,30 @@ func (stack *EventHandlerMap) Lock() {
 	stack.mux.Lock()
 }
 
+/*
+Match returns the handlers an event is delivered to, in order: the handlers
+added for its name, e.g. 'Network.requestWillBeSent', then the handlers added
+for its domain pattern, e.g. 'Network.*', then the handlers added for the '*'
+pattern.
+
+Match is an EventHandlerMapper implementation.
+*/
+func (stack *EventHandlerMap) Match(
+	name string,
+) []EventHandler {
+	stack.Lock()
+	defer stack.Unlock()
+
+	handlers := append([]EventHandler{}, stack.stack[name]...)
+	if dot := strings.Index(name, "."); dot > 0 {
+		handlers = append(handlers, stack.stack[name[:dot]+".*"]...)
+	}
+	if "*" != name {
+		handlers = append(handlers, stack.stack["*"]...)
+	}
+	return handlers
+}
+
 /*
 Remove removes a handler from the stack of handlers for an event.
 
@@ -107,7 +128,7 @@ func (stack *EventHandlerMap) Remove(
 			return nil
 		}
 	}
-	return errs.New(codes.SocketEventHandlerNotFound, fmt.Sprintf("Could not remove handler for '%s': not found", handler.Name()))
+	return codes.New(codes.SocketEventHandlerNotFound, fmt.Sprintf("Could not remove handler for '%s': not found", handler.Name()))
 }
 
 /*
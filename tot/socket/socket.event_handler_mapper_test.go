@@ -36,3 +36,29 @@ func TestEventHandlerMapper(t *testing.T) {
 	// no-op
 	handlerMap.Delete("eventName")
 }
+
+func TestEventHandlerMapMatch(t *testing.T) {
+	handlerMap := NewEventHandlerMap()
+	all := NewEventHandler("*", func(response *Response) {})
+	network := NewEventHandler("Network.*", func(response *Response) {})
+	dataReceived := NewEventHandler("Network.dataReceived", func(response *Response) {})
+	for _, handler := range []EventHandler{all, network, dataReceived} {
+		if err := handlerMap.Add(handler); nil != err {
+			t.Errorf("Expected nil, got error: '%s'", err.Error())
+		}
+	}
+
+	handlers := handlerMap.Match("Network.dataReceived")
+	if 3 != len(handlers) || dataReceived != handlers[0] || network != handlers[1] || all != handlers[2] {
+		t.Errorf("Expected the name, domain and '*' handlers in order, got %v", handlers)
+	}
+	handlers = handlerMap.Match("Page.loadEventFired")
+	if 1 != len(handlers) || all != handlers[0] {
+		t.Errorf("Expected the '*' handler, got %v", handlers)
+	}
+
+	handlerMap.Remove(all)
+	if handlers = handlerMap.Match("Page.loadEventFired"); 0 != len(handlers) {
+		t.Errorf("Expected no handlers, got %v", handlers)
+	}
+}
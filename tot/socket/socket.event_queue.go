@@ -0,0 +1,184 @@
+package socket
+
+import (
+	"sync"
+)
+
+/*
+OverflowPolicy defines how an EventQueue handles events when its buffer is full.
+*/
+type OverflowPolicy int
+
+const (
+	// OverflowBlock blocks Push until the queue has room for the event. No
+	// events are dropped. So that a handler can wait for a command response,
+	// events read from the socket first wait in a backlog of the same size
+	// as the queue without blocking the read loop. Once the backlog is full
+	// the read loop blocks as well until the handler catches up.
+	OverflowBlock OverflowPolicy = iota
+
+	// OverflowDropOldest discards the oldest queued event to make room for the
+	// new event.
+	OverflowDropOldest
+
+	// OverflowDropNewest discards the new event.
+	OverflowDropNewest
+)
+
+/*
+queuedHandler is implemented by event handlers that can deliver their events
+through their own EventQueue.
+*/
+type queuedHandler interface {
+	Queue() *EventQueue
+	SetQueue(queue *EventQueue)
+}
+
+/*
+NewEventQueue returns a running EventQueue that buffers up to size events and
+handles overflow according to policy. A size less than 1 is treated as 1.
+*/
+func NewEventQueue(size int, policy OverflowPolicy) *EventQueue {
+	if size < 1 {
+		size = 1
+	}
+	mux := &sync.Mutex{}
+	queue := &EventQueue{
+		cond:   sync.NewCond(mux),
+		items:  make([]func(), 0, size),
+		mux:    mux,
+		policy: policy,
+		size:   size,
+	}
+	go queue.run()
+	return queue
+}
+
+/*
+EventQueue is a serial queue that delivers events one at a time, in the order
+they were pushed.
+*/
+type EventQueue struct {
+	backlog []func()
+	closed  bool
+	cond    *sync.Cond
+	dropped uint64
+	items   []func()
+	mux     *sync.Mutex
+	policy  OverflowPolicy
+	size    int
+}
+
+/*
+Close stops the queue. Queued events that have not been delivered are discarded
+and any blocked Push calls return.
+*/
+func (queue *EventQueue) Close() {
+	queue.mux.Lock()
+	queue.closed = true
+	queue.backlog = nil
+	queue.items = nil
+	queue.cond.Broadcast()
+	queue.mux.Unlock()
+}
+
+/*
+Dropped returns the number of events discarded because the queue was full.
+*/
+func (queue *EventQueue) Dropped() uint64 {
+	queue.mux.Lock()
+	defer queue.mux.Unlock()
+	return queue.dropped
+}
+
+/*
+Len returns the number of events waiting to be delivered, including the
+backlog of a blocking queue.
+*/
+func (queue *EventQueue) Len() int {
+	queue.mux.Lock()
+	defer queue.mux.Unlock()
+	return len(queue.items) + len(queue.backlog)
+}
+
+/*
+Push adds an event to the queue. If the queue is full the event is handled
+according to the overflow policy. Events pushed to a closed queue are ignored.
+*/
+func (queue *EventQueue) Push(event func()) {
+	queue.mux.Lock()
+	defer queue.mux.Unlock()
+
+	for !queue.closed && (len(queue.items) >= queue.size || len(queue.backlog) > 0) {
+		switch queue.policy {
+		case OverflowDropNewest:
+			queue.dropped++
+			return
+		case OverflowDropOldest:
+			queue.items = queue.items[1:]
+			queue.dropped++
+		default:
+			queue.cond.Wait()
+		}
+	}
+	if queue.closed {
+		return
+	}
+	queue.items = append(queue.items, event)
+	queue.cond.Broadcast()
+}
+
+/*
+post adds an event to the queue. A full blocking queue keeps the event in its
+backlog until there is room and only blocks once the backlog is full as well,
+other policies behave as Push. The socket read loop posts events so a handler
+waiting for a command response doesn't stall it.
+*/
+func (queue *EventQueue) post(event func()) {
+	queue.mux.Lock()
+	if OverflowBlock != queue.policy {
+		queue.mux.Unlock()
+		queue.Push(event)
+		return
+	}
+	defer queue.mux.Unlock()
+
+	for !queue.closed && len(queue.backlog) >= queue.size {
+		queue.cond.Wait()
+	}
+	if queue.closed {
+		return
+	}
+	if len(queue.items) >= queue.size || len(queue.backlog) > 0 {
+		queue.backlog = append(queue.backlog, event)
+		return
+	}
+	queue.items = append(queue.items, event)
+	queue.cond.Broadcast()
+}
+
+/*
+run delivers the queued events until the queue is closed.
+*/
+func (queue *EventQueue) run() {
+	for {
+		queue.mux.Lock()
+		for !queue.closed && 0 == len(queue.items) {
+			queue.cond.Wait()
+		}
+		if queue.closed {
+			queue.mux.Unlock()
+			return
+		}
+		event := queue.items[0]
+		queue.items = queue.items[1:]
+		if len(queue.backlog) > 0 {
+			queue.items = append(queue.items, queue.backlog[0])
+			queue.backlog = queue.backlog[1:]
+		}
+		queue.cond.Broadcast()
+		queue.mux.Unlock()
+
+		event()
+	}
+}
@@ -0,0 +1,260 @@
+package socket
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+func TestEventQueueOrder(t *testing.T) {
+	queue := NewEventQueue(100, OverflowBlock)
+	defer queue.Close()
+
+	results := make(chan int, 100)
+	for a := 0; a < 100; a++ {
+		a := a
+		queue.Push(func() { results <- a })
+	}
+	for a := 0; a < 100; a++ {
+		if result := <-results; a != result {
+			t.Fatalf("Expected %d, got %d", a, result)
+		}
+	}
+	if 0 != queue.Dropped() {
+		t.Errorf("Expected 0 dropped events, got %d", queue.Dropped())
+	}
+}
+
+func TestEventQueueOverflow(t *testing.T) {
+	for _, test := range []struct {
+		policy   OverflowPolicy
+		expected []int
+	}{
+		{OverflowDropNewest, []int{0, 1, 2}},
+		{OverflowDropOldest, []int{0, 3, 4}},
+	} {
+		queue := NewEventQueue(2, test.policy)
+		release := make(chan struct{})
+		results := make(chan int, 5)
+
+		// The first event blocks the queue so the others are buffered.
+		started := make(chan struct{})
+		queue.Push(func() {
+			close(started)
+			<-release
+			results <- 0
+		})
+		<-started
+		for a := 1; a < 5; a++ {
+			a := a
+			queue.Push(func() { results <- a })
+		}
+		if 2 != queue.Len() {
+			t.Errorf("Expected 2 queued events, got %d", queue.Len())
+		}
+		if 2 != queue.Dropped() {
+			t.Errorf("Expected 2 dropped events, got %d", queue.Dropped())
+		}
+
+		close(release)
+		for _, expected := range test.expected {
+			if result := <-results; expected != result {
+				t.Errorf("Policy %d: expected %d, got %d", test.policy, expected, result)
+			}
+		}
+		queue.Close()
+	}
+}
+
+func TestEventQueueBlock(t *testing.T) {
+	queue := NewEventQueue(1, OverflowBlock)
+	release := make(chan struct{})
+	started := make(chan struct{})
+	queue.Push(func() {
+		close(started)
+		<-release
+	})
+	<-started
+	queue.Push(func() {})
+
+	pushed := make(chan struct{})
+	go func() {
+		queue.Push(func() {})
+		close(pushed)
+	}()
+	select {
+	case <-pushed:
+		t.Errorf("Expected Push to block on a full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	queue.Close()
+	select {
+	case <-pushed:
+	case <-time.After(time.Second):
+		t.Errorf("Expected Close to unblock Push")
+	}
+	close(release)
+}
+
+func TestEventQueuePostBacklog(t *testing.T) {
+	queue := NewEventQueue(1, OverflowBlock)
+	release := make(chan struct{})
+	started := make(chan struct{})
+	queue.post(func() {
+		close(started)
+		<-release
+	})
+	<-started
+	queue.post(func() {})
+
+	// The backlog holds as many events as the queue.
+	posted := make(chan struct{})
+	go func() {
+		queue.post(func() {})
+		close(posted)
+	}()
+	select {
+	case <-posted:
+	case <-time.After(time.Second):
+		t.Fatalf("Expected post not to block while the backlog has room")
+	}
+	if 2 != queue.Len() {
+		t.Errorf("Expected 2 queued events, got %d", queue.Len())
+	}
+
+	posted = make(chan struct{})
+	go func() {
+		queue.post(func() {})
+		close(posted)
+	}()
+	select {
+	case <-posted:
+		t.Errorf("Expected post to block on a full backlog")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-posted:
+	case <-time.After(time.Second):
+		t.Errorf("Expected post to return once the queue has room")
+	}
+	queue.Close()
+}
+
+func TestOrderedEvents(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestOrderedEvents")
+	mockSocket := NewMock(socketURL, WithOrderedEvents(100, OverflowBlock))
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	results := make(chan page.MonotonicTime, 50)
+	mockSocket.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		results <- event.Timestamp
+	})
+	for a := 0; a < 50; a++ {
+		mockResultBytes, _ := json.Marshal(&page.LoadEventFiredEvent{Timestamp: page.MonotonicTime(a)})
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			Error:  &Error{},
+			Method: "Page.loadEventFired",
+			Params: mockResultBytes,
+		})
+	}
+	for a := 0; a < 50; a++ {
+		select {
+		case result := <-results:
+			if page.MonotonicTime(a) != result {
+				t.Fatalf("Expected %d, got %v", a, result)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected event %d, got nothing", a)
+		}
+	}
+	if 0 != mockSocket.DroppedEvents() {
+		t.Errorf("Expected 0 dropped events, got %d", mockSocket.DroppedEvents())
+	}
+}
+
+func TestOrderedEventsBlockCommand(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestOrderedEventsBlockCommand")
+	mockSocket := NewMock(socketURL, WithOrderedEvents(1, OverflowBlock))
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	// The first handler waits for a command response that is read after the
+	// queue is full.
+	command := NewCommand(mockSocket, "Some.method", nil)
+	sent := make(chan struct{})
+	results := make(chan page.MonotonicTime, 3)
+	mockSocket.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		if 0 == event.Timestamp {
+			response := mockSocket.SendCommand(command)
+			close(sent)
+			<-response
+		}
+		results <- event.Timestamp
+	})
+	for a := 0; a < 3; a++ {
+		if 1 == a {
+			<-sent
+		}
+		mockResultBytes, _ := json.Marshal(&page.LoadEventFiredEvent{Timestamp: page.MonotonicTime(a)})
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			Error:  &Error{},
+			Method: "Page.loadEventFired",
+			Params: mockResultBytes,
+		})
+	}
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Error:  &Error{},
+		ID:     command.ID(),
+		Result: []byte(`{}`),
+	})
+
+	for a := 0; a < 3; a++ {
+		select {
+		case result := <-results:
+			if page.MonotonicTime(a) != result {
+				t.Fatalf("Expected %d, got %v", a, result)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Expected event %d, the read loop is blocked", a)
+		}
+	}
+}
+
+func TestSubscriptionOrdered(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSubscriptionOrdered")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	events, subscription := mockSocket.Page().SubscribeLoadEventFired(50)
+	subscription.Ordered(50, OverflowDropNewest)
+	for a := 0; a < 50; a++ {
+		mockResultBytes, _ := json.Marshal(&page.LoadEventFiredEvent{Timestamp: page.MonotonicTime(a)})
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			Error:  &Error{},
+			Method: "Page.loadEventFired",
+			Params: mockResultBytes,
+		})
+	}
+	for a := 0; a < 50; a++ {
+		select {
+		case event := <-events:
+			if page.MonotonicTime(a) != event.Timestamp {
+				t.Fatalf("Expected %d, got %v", a, event.Timestamp)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected event %d, got nothing", a)
+		}
+	}
+	if 0 != subscription.Dropped() {
+		t.Errorf("Expected 0 dropped events, got %d", subscription.Dropped())
+	}
+	subscription.Unsubscribe()
+}
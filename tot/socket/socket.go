@@ -10,6 +10,7 @@ package socket
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 /*
@@ -28,15 +29,32 @@ func (err Error) Error() string {
 	return fmt.Sprintf("code=%d, data=%s, msg=%s", err.Code, err.Data, err.Message)
 }
 
+/*
+PendingCommand describes a command that has been sent to the websocket and is
+waiting for a response.
+*/
+type PendingCommand struct {
+	// ID is the command ID.
+	ID int
+
+	// Method is the name of the Chrome DevTools Protocol method that was
+	// called.
+	Method string
+
+	// Sent is the time the command was added to the command stack.
+	Sent time.Time
+}
+
 /*
 Response represents a socket message.
 */
 type Response struct {
-	Error  *Error          `json:"error"`
-	ID     int             `json:"id"`
-	Method string          `json:"method"`
-	Params json.RawMessage `json:"params"`
-	Result json.RawMessage `json:"result"`
+	Error     *Error          `json:"error"`
+	ID        int             `json:"id"`
+	Method    string          `json:"method"`
+	Params    json.RawMessage `json:"params"`
+	Result    json.RawMessage `json:"result"`
+	SessionID string          `json:"sessionId,omitempty"`
 }
 
 /*
@@ -44,7 +62,8 @@ Payload represents a WebSocket JSON payload for a sending a command to the
 websocket.
 */
 type Payload struct {
-	ID     int         `json:"id"`
-	Method string      `json:"method"`
-	Params interface{} `json:"params"`
+	ID        int         `json:"id"`
+	Method    string      `json:"method"`
+	Params    interface{} `json:"params"`
+	SessionID string      `json:"sessionId,omitempty"`
 }
@@ -0,0 +1,51 @@
+package socket
+
+import (
+	"context"
+	"time"
+
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+WithMetrics records command counts, latencies and errors and event counts to
+metrics. Commands sent by the sessions of the socket are recorded as well.
+
+The metrics are collected by outbound and inbound middleware added ahead of any
+middleware added with Use or UseInbound, so command latencies include the time
+spent in other middleware, e.g. retries. Like any outbound middleware this moves
+every command onto the middleware path, where each command is sent and awaited
+on its own goroutine instead of being written directly. Responses with an error
+code of 0 are counted as successes.
+*/
+func WithMetrics(metrics Metrics) Option {
+	return func(socket *Socket) {
+		socket.Use(func(next Sender) Sender {
+			return func(ctx context.Context, payload *Payload) *Response {
+				method := payload.Method
+				start := time.Now()
+				metrics.CommandStarted(method)
+				response := next(ctx, payload)
+				if nil == response {
+					metrics.CommandFinished(method, time.Since(start), &Error{
+						Code:    int(codes.SocketWriteFailed),
+						Message: "middleware returned no response",
+					})
+				} else if nil != response.Error && 0 != response.Error.Code {
+					metrics.CommandFinished(method, time.Since(start), response.Error)
+				} else {
+					metrics.CommandFinished(method, time.Since(start), nil)
+				}
+				return response
+			}
+		})
+		socket.UseInbound(func(next Receiver) Receiver {
+			return func(response *Response) {
+				if 0 == response.ID && "" != response.Method {
+					metrics.EventReceived(response.Method)
+				}
+				next(response)
+			}
+		})
+	}
+}
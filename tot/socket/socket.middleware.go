@@ -0,0 +1,236 @@
+package socket
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/logger"
+)
+
+/*
+Sender delivers a command payload to the websocket connection and blocks until
+the response is received or the context is done. Outbound middleware wraps a
+Sender, see Socket.Use.
+
+A Sender may change the payload before calling the next Sender, call it more
+than once or not at all, and change or replace the response. Responses
+describing a failure carry an Error.
+*/
+type Sender func(ctx context.Context, payload *Payload) *Response
+
+/*
+Receiver handles a message read from the websocket connection: a command
+response, an event or an unknown message. Inbound middleware wraps a Receiver,
+see Socket.UseInbound.
+
+A Receiver may change the message before calling the next Receiver, or drop it
+by not calling the next Receiver.
+*/
+type Receiver func(response *Response)
+
+/*
+middleware holds the outbound and inbound middleware of a socket. The chain of
+a session is composed with the chain of its browser connection, its parent.
+*/
+type middleware struct {
+	inbound  []func(next Receiver) Receiver
+	mux      *sync.Mutex
+	outbound []func(next Sender) Sender
+	parent   *middleware
+}
+
+/*
+newMiddleware returns an empty middleware chain.
+*/
+func newMiddleware() *middleware {
+	return &middleware{mux: &sync.Mutex{}}
+}
+
+/*
+hasInbound returns whether inbound middleware is registered. The parent chain
+isn't included, see receiver.
+*/
+func (chain *middleware) hasInbound() bool {
+	chain.mux.Lock()
+	defer chain.mux.Unlock()
+	return 0 < len(chain.inbound)
+}
+
+/*
+hasOutbound returns whether outbound middleware is registered in the chain or
+its parent.
+*/
+func (chain *middleware) hasOutbound() bool {
+	if nil != chain.parent && chain.parent.hasOutbound() {
+		return true
+	}
+	chain.mux.Lock()
+	defer chain.mux.Unlock()
+	return 0 < len(chain.outbound)
+}
+
+/*
+sender returns the outbound chain wrapping core. The parent chain is closer to
+the connection: the middleware of a session wraps the middleware of its browser
+connection.
+*/
+func (chain *middleware) sender(core Sender) Sender {
+	if nil != chain.parent {
+		core = chain.parent.sender(core)
+	}
+	chain.mux.Lock()
+	defer chain.mux.Unlock()
+	sender := core
+	for a := len(chain.outbound) - 1; a >= 0; a-- {
+		sender = chain.outbound[a](sender)
+	}
+	return sender
+}
+
+/*
+receiver returns the inbound chain wrapping core. The parent chain isn't
+included, the read loop of the browser connection applies it to every message
+before the messages of a session pass through the session's chain.
+*/
+func (chain *middleware) receiver(core Receiver) Receiver {
+	chain.mux.Lock()
+	defer chain.mux.Unlock()
+	receiver := core
+	for a := len(chain.inbound) - 1; a >= 0; a-- {
+		receiver = chain.inbound[a](receiver)
+	}
+	return receiver
+}
+
+/*
+Use adds outbound middleware to the socket. Every command sent through the
+socket, including the commands of its sessions, passes through the middleware
+in the order it was added. Middleware added to a Session only sees the commands
+of the session, before they pass through the middleware of the browser
+connection, e.g.
+
+	soc.Use(func(next socket.Sender) socket.Sender {
+		return func(ctx context.Context, payload *socket.Payload) *socket.Response {
+			start := time.Now()
+			response := next(ctx, payload)
+			log.Printf("%s took %s", payload.Method, time.Since(start))
+			return response
+		}
+	})
+*/
+func (socket *Socket) Use(middleware ...func(next Sender) Sender) {
+	socket.middleware.mux.Lock()
+	defer socket.middleware.mux.Unlock()
+	socket.middleware.outbound = append(socket.middleware.outbound, middleware...)
+}
+
+/*
+UseInbound adds inbound middleware to the socket. Every message read from the
+websocket connection, including the events of its sessions, passes through the
+middleware in the order it was added before it is delivered to the waiting
+command or the event handlers. Middleware added to a Session only sees the
+messages of the session, after they passed through the middleware of the
+browser connection.
+*/
+func (socket *Socket) UseInbound(middleware ...func(next Receiver) Receiver) {
+	socket.middleware.mux.Lock()
+	defer socket.middleware.mux.Unlock()
+	socket.middleware.inbound = append(socket.middleware.inbound, middleware...)
+}
+
+/*
+middlewareFor returns the middleware chain of the socket or of one of its
+sessions.
+*/
+func (socket *Socket) middlewareFor(sessionID string) *middleware {
+	if "" == sessionID {
+		return socket.middleware
+	}
+	socket.sessionsMux.Lock()
+	defer socket.sessionsMux.Unlock()
+	if session, ok := socket.sessions[sessionID]; ok {
+		return session.middleware
+	}
+	return socket.middleware
+}
+
+/*
+sendThrough delivers a command through the outbound middleware. Each call of
+the innermost Sender stores a command for the payload, writes the payload and
+waits for its response.
+*/
+func (socket *Socket) sendThrough(
+	ctx context.Context,
+	chain *middleware,
+	command Commander,
+	payload *Payload,
+) {
+	var sentMux sync.Mutex
+	var sent *Command
+	send := chain.sender(func(ctx context.Context, payload *Payload) *Response {
+		attempt := &Command{
+			id:       payload.ID,
+			method:   payload.Method,
+			params:   payload.Params,
+			response: make(chan *Response, 1),
+			socket:   socket,
+		}
+		sentMux.Lock()
+		sent = attempt
+		sentMux.Unlock()
+		return socket.send(ctx, attempt, payload)
+	})
+
+	response := send(ctx, payload)
+	if nil == response {
+		response = &Response{Error: &Error{
+			Code:    int(codes.SocketWriteFailed),
+			Message: fmt.Sprintf("middleware returned no response for command #%d '%s'", command.ID(), command.Method()),
+		}}
+	}
+	sentMux.Lock()
+	if nil != sent && nil != sent.Error() {
+		command.SetError(sent.Error())
+	}
+	sentMux.Unlock()
+	command.Respond(response)
+}
+
+/*
+send stores a command, writes its payload and waits for the response.
+*/
+func (socket *Socket) send(ctx context.Context, command *Command, payload *Payload) *Response {
+	socket.commands.Set(command)
+	if err := socket.WriteJSON(payload); nil != err {
+		if _, popErr := socket.commands.Pop(command.ID()); nil == popErr {
+			err = codes.Wrap(err, codes.SocketWriteFailed, "write failed: could not write data to websocket")
+			socket.logger.Debug("command write failed", logger.Fields{"commandID": command.ID(), "error": err, "socketID": socket.socketID})
+			command.SetError(err)
+			return &Response{
+				Error: &Error{
+					Code:    int(codes.SocketWriteFailed),
+					Message: err.Error(),
+				},
+				ID: command.ID(),
+			}
+		}
+	}
+
+	select {
+	case response := <-command.Response():
+		return response
+	case <-ctx.Done():
+		socket.commands.Delete(command.ID())
+		err := codes.Wrap(ctx.Err(), codes.SocketCommandCancelled, fmt.Sprintf("command #%d '%s' cancelled", command.ID(), command.Method()))
+		command.SetError(err)
+		return &Response{
+			Error: &Error{
+				Code:    int(codes.SocketCommandCancelled),
+				Message: err.Error(),
+			},
+			ID: command.ID(),
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+middlewareWebSocket answers every command with its params and session ID and
+fails the first attempt of Page.reload.
+*/
+type middlewareWebSocket struct {
+	closed    chan struct{}
+	mux       sync.Mutex
+	payloads  []*Payload
+	reloads   int
+	responses chan *Response
+}
+
+func newMiddlewareWebSocket() *middlewareWebSocket {
+	return &middlewareWebSocket{
+		closed:    make(chan struct{}),
+		responses: make(chan *Response, 10),
+	}
+}
+
+func (conn *middlewareWebSocket) Close() error {
+	close(conn.closed)
+	return nil
+}
+
+func (conn *middlewareWebSocket) ReadJSON(v interface{}) error {
+	select {
+	case response := <-conn.responses:
+		data, _ := json.Marshal(response)
+		return json.Unmarshal(data, v)
+	case <-conn.closed:
+		return errors.New("connection closed")
+	}
+}
+
+func (conn *middlewareWebSocket) WriteJSON(v interface{}) error {
+	payload := v.(*Payload)
+	conn.mux.Lock()
+	conn.payloads = append(conn.payloads, payload)
+	if "Page.reload" == payload.Method {
+		conn.reloads++
+	}
+	reloads := conn.reloads
+	conn.mux.Unlock()
+
+	if "Page.reload" == payload.Method && 1 == reloads {
+		conn.responses <- &Response{ID: payload.ID, Error: &Error{Code: -32000, Message: "busy"}}
+		return nil
+	}
+	params, _ := json.Marshal(payload.Params)
+	conn.responses <- &Response{ID: payload.ID, Result: params, SessionID: payload.SessionID}
+	return nil
+}
+
+func TestSocketUse(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketUse")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+
+	order := []string{}
+	soc.Use(
+		func(next Sender) Sender {
+			return func(ctx context.Context, payload *Payload) *Response {
+				order = append(order, "first")
+				return next(ctx, payload)
+			}
+		},
+		func(next Sender) Sender {
+			return func(ctx context.Context, payload *Payload) *Response {
+				order = append(order, "second")
+				return next(ctx, payload)
+			}
+		},
+	)
+	soc.Use(func(next Sender) Sender {
+		return func(ctx context.Context, payload *Payload) *Response {
+			switch payload.Method {
+			case "Page.navigate":
+				// Rewrite the command.
+				payload.Params = &page.NavigateParams{URL: "https://example.com/rewritten"}
+			case "Page.bringToFront":
+				// Enforce a policy.
+				return &Response{ID: payload.ID, Error: &Error{Code: -1, Message: "not allowed"}}
+			case "Page.reload":
+				// Retry once with a new command ID.
+				response := next(ctx, payload)
+				if nil == response.Error {
+					return response
+				}
+				payload.ID = soc.NextCommandID()
+			}
+			return next(ctx, payload)
+		}
+	})
+
+	navigate := <-soc.Page().Navigate(&page.NavigateParams{URL: "https://example.com/"})
+	if nil != navigate.Err {
+		t.Fatalf("Expected nil, got error: %v", navigate.Err)
+	}
+	if 2 != len(order) || "first" != order[0] || "second" != order[1] {
+		t.Errorf("Expected the middleware to run in order, got %v", order)
+	}
+	if "https://example.com/rewritten" != conn.payloads[0].Params.(*page.NavigateParams).URL {
+		t.Errorf("Expected the rewritten URL, got %v", conn.payloads[0].Params)
+	}
+
+	if result := <-soc.Page().BringToFront(); nil == result.Err {
+		t.Errorf("Expected error, got nil")
+	}
+	if 1 != len(conn.payloads) {
+		t.Errorf("Expected the rejected command not to be sent, got %d payloads", len(conn.payloads))
+	}
+
+	if result := <-soc.Page().Reload(&page.ReloadParams{}); nil != result.Err {
+		t.Errorf("Expected nil, got error: %v", result.Err)
+	}
+	if 3 != len(conn.payloads) {
+		t.Errorf("Expected the command to be retried, got %d payloads", len(conn.payloads))
+	}
+	if 0 != len(soc.Pending()) {
+		t.Errorf("Expected no pending commands, got %d", len(soc.Pending()))
+	}
+}
+
+func TestSocketUseCancelled(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketUseCancelled")
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		// Never responds.
+		return &MockChromeWebSocket{}, nil
+	}))
+	defer soc.Stop()
+	soc.Use(func(next Sender) Sender {
+		return next
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	result := <-soc.Page().EnableContext(ctx, nil)
+	if nil == result.Err {
+		t.Fatalf("Expected error, got nil")
+	}
+	if 0 != len(soc.Pending()) {
+		t.Errorf("Expected no pending commands, got %d", len(soc.Pending()))
+	}
+}
+
+func TestSocketUseInbound(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketUseInbound")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+
+	soc.UseInbound(func(next Receiver) Receiver {
+		return func(response *Response) {
+			switch response.Method {
+			case "Page.frameNavigated":
+				// Drop the event.
+				return
+			case "Page.loadEventFired":
+				// Rewrite the event.
+				response.Params = []byte(`{"timestamp":2}`)
+			}
+			next(response)
+		}
+	})
+
+	loaded := make(chan *page.LoadEventFiredEvent, 1)
+	soc.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		loaded <- event
+	})
+	navigated := make(chan *page.FrameNavigatedEvent, 1)
+	soc.Page().OnFrameNavigated(func(event *page.FrameNavigatedEvent) {
+		navigated <- event
+	})
+
+	// Connect, then deliver the events.
+	<-soc.Page().Enable(nil)
+	conn.responses <- &Response{Method: "Page.frameNavigated", Params: []byte(`{}`)}
+	conn.responses <- &Response{Method: "Page.loadEventFired", Params: []byte(`{"timestamp":1}`)}
+
+	select {
+	case event := <-loaded:
+		if 2 != event.Timestamp {
+			t.Errorf("Expected the rewritten timestamp 2, got %v", event.Timestamp)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected a Page.loadEventFired event")
+	}
+	select {
+	case <-navigated:
+		t.Errorf("Expected the Page.frameNavigated event to be dropped")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
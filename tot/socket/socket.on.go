@@ -0,0 +1,47 @@
+package socket
+
+import (
+	"encoding/json"
+)
+
+/*
+On adds an event handler for any protocol event, including events that don't
+have a protocol wrapper yet. The callback receives the raw event parameters.
+
+On is a Socketer implementation.
+*/
+func (socket *Socket) On(
+	method string,
+	callback func(params json.RawMessage),
+) *Subscription {
+	handler := NewEventHandler(
+		method,
+		func(response *Response) {
+			callback(response.Params)
+		},
+	)
+	return Subscribe(socket, handler)
+}
+
+/*
+OnPattern adds an event handler for every event matching a pattern: '*' matches
+all events and a domain pattern such as 'Network.*' matches all events of the
+domain. The callback receives the event name and the raw event parameters.
+
+Pattern handlers are delivered events after the handlers for the event name,
+follow the same ordering rules and are removed with Subscription.Unsubscribe.
+
+OnPattern is a Socketer implementation.
+*/
+func (socket *Socket) OnPattern(
+	pattern string,
+	callback func(method string, params json.RawMessage),
+) *Subscription {
+	handler := NewEventHandler(
+		pattern,
+		func(response *Response) {
+			callback(response.Method, response.Params)
+		},
+	)
+	return Subscribe(socket, handler)
+}
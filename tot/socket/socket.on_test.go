@@ -0,0 +1,100 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSocketOn(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketOn")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+
+	received := make(chan json.RawMessage, 1)
+	subscription := soc.On("Page.newEvent", func(params json.RawMessage) {
+		received <- params
+	})
+	defer subscription.Unsubscribe()
+
+	// Connect, then deliver the event.
+	soc.Call(context.Background(), "Page.enable", nil, nil)
+	conn.responses <- &Response{Method: "Page.newEvent", Params: []byte(`{"value":1}`)}
+
+	select {
+	case params := <-received:
+		if `{"value":1}` != string(params) {
+			t.Errorf(`Expected '{"value":1}', got '%s'`, params)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected a Page.newEvent event")
+	}
+}
+
+func TestSocketOnPattern(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketOnPattern")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL,
+		WithWebSocket(func(*url.URL) (WebSocketer, error) {
+			return conn, nil
+		}),
+		WithOrderedEvents(10, OverflowBlock),
+	)
+	defer soc.Stop()
+
+	received := make(chan string, 10)
+	soc.OnPattern("*", func(method string, params json.RawMessage) {
+		received <- "*:" + method + string(params)
+	})
+	network := soc.OnPattern("Network.*", func(method string, params json.RawMessage) {
+		received <- "Network.*:" + method + string(params)
+	})
+	soc.On("Network.dataReceived", func(params json.RawMessage) {
+		received <- "Network.dataReceived:" + string(params)
+	})
+
+	// Connect, then deliver the events.
+	soc.Call(context.Background(), "Network.enable", nil, nil)
+	conn.responses <- &Response{Method: "Network.dataReceived", Params: []byte(`{"a":1}`)}
+	conn.responses <- &Response{Method: "Page.loadEventFired", Params: []byte(`{"b":2}`)}
+
+	expected := []string{
+		`Network.dataReceived:{"a":1}`,
+		`Network.*:Network.dataReceived{"a":1}`,
+		`*:Network.dataReceived{"a":1}`,
+		`*:Page.loadEventFired{"b":2}`,
+	}
+	for _, want := range expected {
+		select {
+		case got := <-received:
+			if want != got {
+				t.Errorf("Expected '%s', got '%s'", want, got)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected '%s', got nothing", want)
+		}
+	}
+
+	if err := network.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+	conn.responses <- &Response{Method: "Network.loadingFinished", Params: []byte(`{}`)}
+	select {
+	case got := <-received:
+		if `*:Network.loadingFinished{}` != got {
+			t.Errorf("Expected '*:Network.loadingFinished{}', got '%s'", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected a Network.loadingFinished event")
+	}
+	select {
+	case got := <-received:
+		t.Errorf("Expected the Network.* handler to be removed, got '%s'", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
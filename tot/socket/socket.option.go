@@ -0,0 +1,83 @@
+package socket
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/mkenney/go-chrome/logger"
+)
+
+/*
+Option defines a configuration function for Socket instances. Options are
+applied by New() before the socket starts listening.
+*/
+type Option func(socket *Socket)
+
+/*
+WithReconnect enables automatic reconnection using the provided policy. When the
+websocket connection is lost, in-flight commands fail with a
+codes.SocketConnectionLost error and the socket re-dials with exponential
+backoff, restoring the protocol domains that were enabled.
+*/
+func WithReconnect(policy *ReconnectPolicy) Option {
+	return func(socket *Socket) {
+		socket.reconnectPolicy = policy
+	}
+}
+
+/*
+WithCommandTimeout sets the default amount of time a command waits for a
+response before it fails with a codes.SocketCommandTimeout error. Timed out
+commands are removed from the command stack by a background reaper. A zero
+value, the default, disables the timeout.
+*/
+func WithCommandTimeout(timeout time.Duration) Option {
+	return func(socket *Socket) {
+		socket.commandTimeout = timeout
+	}
+}
+
+/*
+WithOrderedEvents delivers events to the event handlers through a serial queue,
+in the order they were read from the websocket, instead of running the handlers
+concurrently. The queue buffers up to size events and handles overflow according
+to policy.
+*/
+func WithOrderedEvents(size int, policy OverflowPolicy) Option {
+	return func(socket *Socket) {
+		socket.events = NewEventQueue(size, policy)
+	}
+}
+
+/*
+WithWebSocket sets the function used to open the websocket connection. It
+defaults to NewWebsocket and allows alternate transports and test doubles to be
+used.
+*/
+func WithWebSocket(newSocket func(socketURL *url.URL) (WebSocketer, error)) Option {
+	return func(socket *Socket) {
+		socket.newSocket = newSocket
+	}
+}
+
+/*
+WithLogger sets the logger of the socket and its sessions. Command parameters,
+results and raw messages are redacted unless WithPayloadLogging is used. By
+default nothing is logged.
+*/
+func WithLogger(log logger.Logger) Option {
+	return func(socket *Socket) {
+		socket.logger = log
+	}
+}
+
+/*
+WithPayloadLogging disables the redaction of command parameters, results,
+errors and raw messages in log entries. They may contain page content, cookies
+and credentials.
+*/
+func WithPayloadLogging() Option {
+	return func(socket *Socket) {
+		socket.logPayloads = true
+	}
+}
@@ -0,0 +1,105 @@
+package socket
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/logger"
+)
+
+type captureLogger struct {
+	entries []string
+	fields  []logger.Fields
+	mux     sync.Mutex
+}
+
+func (capture *captureLogger) log(level, msg string, fields logger.Fields) {
+	capture.mux.Lock()
+	defer capture.mux.Unlock()
+	capture.entries = append(capture.entries, fmt.Sprintf("%s %s %v", level, msg, fields))
+	capture.fields = append(capture.fields, fields)
+}
+func (capture *captureLogger) Debug(msg string, fields logger.Fields) {
+	capture.log("debug", msg, fields)
+}
+func (capture *captureLogger) Info(msg string, fields logger.Fields) {
+	capture.log("info", msg, fields)
+}
+func (capture *captureLogger) Warn(msg string, fields logger.Fields) {
+	capture.log("warn", msg, fields)
+}
+func (capture *captureLogger) Error(msg string, fields logger.Fields) {
+	capture.log("error", msg, fields)
+}
+
+func (capture *captureLogger) String() string {
+	capture.mux.Lock()
+	defer capture.mux.Unlock()
+	return strings.Join(capture.entries, "\n")
+}
+
+func TestWithLogger(t *testing.T) {
+	for _, logPayloads := range []bool{false, true} {
+		socketURL, _ := url.Parse("https://test:9222/TestWithLogger")
+		conn := newMiddlewareWebSocket()
+		capture := &captureLogger{}
+		options := []Option{
+			WithWebSocket(func(*url.URL) (WebSocketer, error) {
+				return conn, nil
+			}),
+			WithLogger(capture),
+		}
+		if logPayloads {
+			options = append(options, WithPayloadLogging())
+		}
+		soc := New(socketURL, options...)
+
+		<-soc.Page().Enable(nil)
+		conn.responses <- &Response{ID: 99, Result: []byte(`"secret"`)}
+		conn.responses <- &Response{Result: []byte(`"secret"`)}
+		time.Sleep(50 * time.Millisecond)
+		soc.Stop()
+
+		entries := capture.String()
+		if !strings.Contains(entries, "info New socket connection listening") {
+			t.Errorf("Expected the socket to log to the logger, got:\n%s", entries)
+		}
+		if !strings.Contains(entries, "error Unknown response from web socket") {
+			t.Errorf("Expected the unknown response to be logged, got:\n%s", entries)
+		}
+		if logPayloads == strings.Contains(entries, logger.Redacted) {
+			t.Errorf("Expected payload logging %v, got:\n%s", logPayloads, entries)
+		}
+		if logPayloads != strings.Contains(entries, "secret") {
+			t.Errorf("Expected payload logging %v, got:\n%s", logPayloads, entries)
+		}
+	}
+}
+
+func TestWithLoggerRedactsErrors(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestWithLoggerRedactsErrors")
+	capture := &captureLogger{}
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}), WithLogger(capture))
+	defer soc.Stop()
+
+	soc.handleUnknown(&Response{ID: 99, Error: &Error{
+		Code:    -32000,
+		Data:    []byte(`"secret"`),
+		Message: "Internal error",
+	}})
+
+	entries := capture.String()
+	if strings.Contains(entries, "secret") {
+		t.Errorf("Expected the protocol error to be redacted, got:\n%s", entries)
+	}
+	if !strings.Contains(entries, "error:"+logger.Redacted) {
+		t.Errorf("Expected the error field to be redacted, got:\n%s", entries)
+	}
+}
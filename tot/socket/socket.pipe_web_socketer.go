@@ -0,0 +1,87 @@
+package socket
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+NewPipeWebsocket returns a WebSocketer that exchanges messages with Chromium
+over a pair of pipes, as used by the --remote-debugging-pipe flag. Messages are
+read from reader, Chromium's file descriptor 4, and written to writer,
+Chromium's file descriptor 3.
+*/
+func NewPipeWebsocket(reader io.ReadCloser, writer io.WriteCloser) *PipeWebSocket {
+	return &PipeWebSocket{
+		closer:   reader,
+		reader:   bufio.NewReader(reader),
+		writeMux: &sync.Mutex{},
+		writer:   writer,
+	}
+}
+
+/*
+PipeWebSocket provides a WebSocketer interface for a Chromium debugging pipe.
+Each message is a JSON document terminated by a NUL byte. Unlike websocket
+connections, pipe messages are not limited to 1MB.
+
+PipeWebSocket represents a WebSocketer interface
+*/
+type PipeWebSocket struct {
+	closeOnce sync.Once
+	closer    io.Closer
+	reader    *bufio.Reader
+	writeMux  *sync.Mutex
+	writer    io.WriteCloser
+}
+
+/*
+Close closes both pipes.
+
+Close is a WebSocketer implementation.
+*/
+func (socket *PipeWebSocket) Close() error {
+	var err error
+	socket.closeOnce.Do(func() {
+		err = socket.writer.Close()
+		if closeErr := socket.closer.Close(); nil == err {
+			err = closeErr
+		}
+	})
+	return err
+}
+
+/*
+ReadJSON reads the next NUL-delimited message from the pipe and unmarshalls it
+into the provided variable.
+
+ReadJSON is a WebSocketer implementation.
+*/
+func (socket *PipeWebSocket) ReadJSON(v interface{}) error {
+	message, err := socket.reader.ReadBytes(0)
+	if nil != err {
+		return codes.Wrap(err, codes.WebsocketNotConnected, "pipe read failed")
+	}
+	return json.Unmarshal(message[:len(message)-1], v)
+}
+
+/*
+WriteJSON marshalls the provided data as JSON and writes it to the pipe followed
+by a NUL byte.
+
+WriteJSON is a WebSocketer implementation.
+*/
+func (socket *PipeWebSocket) WriteJSON(v interface{}) error {
+	message, err := json.Marshal(v)
+	if nil != err {
+		return err
+	}
+	socket.writeMux.Lock()
+	defer socket.writeMux.Unlock()
+	_, err = socket.writer.Write(append(message, 0))
+	return err
+}
@@ -0,0 +1,69 @@
+package socket
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/url"
+	"testing"
+)
+
+func TestPipeWebSocket(t *testing.T) {
+	// The pipes as seen from Chromium.
+	commandReader, commandWriter := io.Pipe()
+	messageReader, messageWriter := io.Pipe()
+	conn := NewPipeWebsocket(messageReader, commandWriter)
+
+	go func() {
+		reader := bufio.NewReader(commandReader)
+		for {
+			message, err := reader.ReadBytes(0)
+			if nil != err {
+				return
+			}
+			payload := &Payload{}
+			if err := json.Unmarshal(message[:len(message)-1], payload); nil != err {
+				t.Errorf("Expected nil, got error: %v", err)
+				return
+			}
+			response, _ := json.Marshal(&Response{
+				ID:     payload.ID,
+				Result: []byte(`{"method":"` + payload.Method + `"}`),
+			})
+			messageWriter.Write(append(response, 0))
+		}
+	}()
+
+	socketURL, _ := url.Parse("pipe://browser")
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+
+	response := <-soc.SendCommand(NewCommand(soc, "Browser.getVersion", nil))
+	if nil != response.Error {
+		t.Fatalf("Expected nil, got error: %v", response.Error)
+	}
+	if `{"method":"Browser.getVersion"}` != string(response.Result) {
+		t.Errorf("Expected the command method, got '%s'", response.Result)
+	}
+}
+
+func TestPipeWebSocketClose(t *testing.T) {
+	commandReader, commandWriter := io.Pipe()
+	messageReader, _ := io.Pipe()
+	conn := NewPipeWebsocket(messageReader, commandWriter)
+
+	if err := conn.Close(); nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+	if err := conn.Close(); nil != err {
+		t.Errorf("Expected nil, got error: %v", err)
+	}
+	if err := conn.ReadJSON(&Response{}); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+	if _, err := commandReader.Read(make([]byte, 1)); io.EOF != err {
+		t.Errorf("Expected EOF, got %v", err)
+	}
+}
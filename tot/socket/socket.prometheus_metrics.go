@@ -0,0 +1,227 @@
+package socket
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+DefaultLatencyBuckets are the upper bounds, in seconds, of the command latency
+histogram buckets used by NewPrometheusMetrics when none are specified.
+*/
+var DefaultLatencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30}
+
+/*
+NewPrometheusMetrics returns a Metrics implementation that renders the
+collected metrics in the Prometheus text exposition format. buckets are the
+upper bounds, in seconds, of the command latency histogram buckets.
+
+PrometheusMetrics is an http.Handler and can be mounted on any http.ServeMux:
+
+	metrics := socket.NewPrometheusMetrics()
+	soc := socket.New(socketURL, socket.WithMetrics(metrics))
+	http.Handle("/metrics", metrics)
+*/
+func NewPrometheusMetrics(buckets ...float64) *PrometheusMetrics {
+	if 0 == len(buckets) {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = append([]float64{}, buckets...)
+	sort.Float64s(buckets)
+	return &PrometheusMetrics{
+		buckets:  buckets,
+		commands: make(map[string]*commandMetrics),
+		events:   make(map[string]uint64),
+		mux:      &sync.Mutex{},
+	}
+}
+
+/*
+PrometheusMetrics is a Metrics implementation exposing the following metrics:
+
+	cdp_commands_total{method}                  commands sent
+	cdp_command_errors_total{method,code}       commands failed, by error code
+	cdp_commands_in_flight{method}              commands waiting for a response
+	cdp_command_duration_seconds{method}        command latency histogram
+	cdp_events_total{event}                     events received
+*/
+type PrometheusMetrics struct {
+	buckets  []float64
+	commands map[string]*commandMetrics
+	events   map[string]uint64
+	mux      *sync.Mutex
+}
+
+/*
+commandMetrics holds the metrics of a protocol method.
+*/
+type commandMetrics struct {
+	buckets  []uint64
+	count    uint64
+	errors   map[int]uint64
+	inFlight int64
+	sum      float64
+	total    uint64
+}
+
+/*
+CommandStarted is a Metrics implementation.
+*/
+func (metrics *PrometheusMetrics) CommandStarted(method string) {
+	metrics.mux.Lock()
+	defer metrics.mux.Unlock()
+	command := metrics.command(method)
+	command.total++
+	command.inFlight++
+}
+
+/*
+CommandFinished is a Metrics implementation.
+*/
+func (metrics *PrometheusMetrics) CommandFinished(method string, latency time.Duration, err *Error) {
+	metrics.mux.Lock()
+	defer metrics.mux.Unlock()
+	command := metrics.command(method)
+	command.inFlight--
+	if nil != err {
+		command.errors[err.Code]++
+	}
+
+	seconds := latency.Seconds()
+	command.count++
+	command.sum += seconds
+	for a, bound := range metrics.buckets {
+		if seconds <= bound {
+			command.buckets[a]++
+		}
+	}
+}
+
+/*
+EventReceived is a Metrics implementation.
+*/
+func (metrics *PrometheusMetrics) EventReceived(name string) {
+	metrics.mux.Lock()
+	defer metrics.mux.Unlock()
+	metrics.events[name]++
+}
+
+/*
+ServeHTTP renders the metrics in the Prometheus text exposition format.
+*/
+func (metrics *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	metrics.Write(w)
+}
+
+/*
+Write writes the metrics to w in the Prometheus text exposition format.
+*/
+func (metrics *PrometheusMetrics) Write(w io.Writer) error {
+	metrics.mux.Lock()
+	defer metrics.mux.Unlock()
+
+	methods := make([]string, 0, len(metrics.commands))
+	for method := range metrics.commands {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	out := bufio.NewWriter(w)
+
+	fmt.Fprintln(out, "# HELP cdp_commands_total Number of protocol commands sent.")
+	fmt.Fprintln(out, "# TYPE cdp_commands_total counter")
+	for _, method := range methods {
+		fmt.Fprintf(out, "cdp_commands_total{method=\"%s\"} %d\n", escapeLabel(method), metrics.commands[method].total)
+	}
+
+	fmt.Fprintln(out, "# HELP cdp_command_errors_total Number of protocol commands that failed, by error code.")
+	fmt.Fprintln(out, "# TYPE cdp_command_errors_total counter")
+	for _, method := range methods {
+		errors := metrics.commands[method].errors
+		codes := make([]int, 0, len(errors))
+		for code := range errors {
+			codes = append(codes, code)
+		}
+		sort.Ints(codes)
+		for _, code := range codes {
+			fmt.Fprintf(out, "cdp_command_errors_total{method=\"%s\",code=\"%d\"} %d\n", escapeLabel(method), code, errors[code])
+		}
+	}
+
+	fmt.Fprintln(out, "# HELP cdp_commands_in_flight Number of protocol commands waiting for a response.")
+	fmt.Fprintln(out, "# TYPE cdp_commands_in_flight gauge")
+	for _, method := range methods {
+		fmt.Fprintf(out, "cdp_commands_in_flight{method=\"%s\"} %d\n", escapeLabel(method), metrics.commands[method].inFlight)
+	}
+
+	fmt.Fprintln(out, "# HELP cdp_command_duration_seconds Protocol command latency.")
+	fmt.Fprintln(out, "# TYPE cdp_command_duration_seconds histogram")
+	for _, method := range methods {
+		command := metrics.commands[method]
+		label := escapeLabel(method)
+		for a, bound := range metrics.buckets {
+			fmt.Fprintf(out, "cdp_command_duration_seconds_bucket{method=\"%s\",le=\"%s\"} %d\n", label, formatFloat(bound), command.buckets[a])
+		}
+		fmt.Fprintf(out, "cdp_command_duration_seconds_bucket{method=\"%s\",le=\"+Inf\"} %d\n", label, command.count)
+		fmt.Fprintf(out, "cdp_command_duration_seconds_sum{method=\"%s\"} %s\n", label, formatFloat(command.sum))
+		fmt.Fprintf(out, "cdp_command_duration_seconds_count{method=\"%s\"} %d\n", label, command.count)
+	}
+
+	events := make([]string, 0, len(metrics.events))
+	for event := range metrics.events {
+		events = append(events, event)
+	}
+	sort.Strings(events)
+
+	fmt.Fprintln(out, "# HELP cdp_events_total Number of protocol events received.")
+	fmt.Fprintln(out, "# TYPE cdp_events_total counter")
+	for _, event := range events {
+		fmt.Fprintf(out, "cdp_events_total{event=\"%s\"} %d\n", escapeLabel(event), metrics.events[event])
+	}
+
+	return out.Flush()
+}
+
+/*
+command returns the metrics of a protocol method, creating them if necessary.
+The caller must hold the mutex.
+*/
+func (metrics *PrometheusMetrics) command(method string) *commandMetrics {
+	command, ok := metrics.commands[method]
+	if !ok {
+		command = &commandMetrics{
+			buckets: make([]uint64, len(metrics.buckets)),
+			errors:  make(map[int]uint64),
+		}
+		metrics.commands[method] = command
+	}
+	return command
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+/*
+escapeLabel escapes a Prometheus label value.
+*/
+func escapeLabel(value string) string {
+	return labelEscaper.Replace(value)
+}
+
+/*
+formatFloat formats a Prometheus sample value.
+*/
+func formatFloat(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,130 @@
+package socket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPrometheusMetrics")
+	conn := newMiddlewareWebSocket()
+	metrics := NewPrometheusMetrics(0.5, 1)
+	soc := New(socketURL,
+		WithWebSocket(func(*url.URL) (WebSocketer, error) {
+			return conn, nil
+		}),
+		WithMetrics(metrics),
+	)
+	defer soc.Stop()
+
+	loaded := make(chan *page.LoadEventFiredEvent, 1)
+	soc.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		loaded <- event
+	})
+
+	<-soc.Page().Enable(nil)
+	<-soc.Page().Enable(nil)
+	if result := <-soc.Page().Reload(&page.ReloadParams{}); nil == result.Err {
+		t.Errorf("Expected error, got nil")
+	}
+	conn.responses <- &Response{Method: "Page.loadEventFired", Params: []byte(`{"timestamp":1}`)}
+	select {
+	case <-loaded:
+	case <-time.After(time.Second):
+		t.Fatalf("Expected a Page.loadEventFired event")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	if !strings.HasPrefix(recorder.Header().Get("Content-Type"), "text/plain; version=0.0.4") {
+		t.Errorf("Expected the text exposition content type, got '%s'", recorder.Header().Get("Content-Type"))
+	}
+
+	body := recorder.Body.String()
+	for _, expected := range []string{
+		"# TYPE cdp_commands_total counter\n",
+		`cdp_commands_total{method="Page.enable"} 2` + "\n",
+		`cdp_commands_total{method="Page.reload"} 1` + "\n",
+		`cdp_command_errors_total{method="Page.reload",code="-32000"} 1` + "\n",
+		`cdp_commands_in_flight{method="Page.enable"} 0` + "\n",
+		"# TYPE cdp_command_duration_seconds histogram\n",
+		`cdp_command_duration_seconds_bucket{method="Page.enable",le="0.5"} 2` + "\n",
+		`cdp_command_duration_seconds_bucket{method="Page.enable",le="1"} 2` + "\n",
+		`cdp_command_duration_seconds_bucket{method="Page.enable",le="+Inf"} 2` + "\n",
+		`cdp_command_duration_seconds_count{method="Page.enable"} 2` + "\n",
+		`cdp_events_total{event="Page.loadEventFired"} 1` + "\n",
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("Expected the metrics to contain '%s', got:\n%s", strings.TrimSpace(expected), body)
+		}
+	}
+	if strings.Contains(body, `cdp_command_errors_total{method="Page.enable"`) {
+		t.Errorf("Expected no Page.enable errors, got:\n%s", body)
+	}
+}
+
+/*
+zeroErrorWebSocket answers every command with an error object with code 0, as
+some browsers do for successful commands.
+*/
+type zeroErrorWebSocket struct {
+	*middlewareWebSocket
+}
+
+func (conn zeroErrorWebSocket) WriteJSON(v interface{}) error {
+	conn.responses <- &Response{ID: v.(*Payload).ID, Error: &Error{}, Result: []byte(`{}`)}
+	return nil
+}
+
+func TestMetricsZeroErrorCode(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestMetricsZeroErrorCode")
+	metrics := NewPrometheusMetrics()
+	soc := New(socketURL,
+		WithWebSocket(func(*url.URL) (WebSocketer, error) {
+			return zeroErrorWebSocket{newMiddlewareWebSocket()}, nil
+		}),
+		WithMetrics(metrics),
+	)
+	defer soc.Stop()
+
+	if result := <-soc.Page().Enable(nil); nil != result.Err {
+		t.Fatalf("Expected nil, got error: %v", result.Err)
+	}
+	recorder := httptest.NewRecorder()
+	metrics.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	if body := recorder.Body.String(); strings.Contains(body, "cdp_command_errors_total{") {
+		t.Errorf("Expected no command errors, got:\n%s", body)
+	}
+}
+
+func TestPrometheusMetricsInFlight(t *testing.T) {
+	metrics := NewPrometheusMetrics()
+	metrics.CommandStarted("Page.navigate")
+	metrics.CommandStarted("Page.navigate")
+	metrics.CommandFinished("Page.navigate", 20*time.Millisecond, nil)
+	metrics.CommandStarted("Runtime.evaluate \"quoted\"")
+
+	out := &strings.Builder{}
+	if err := metrics.Write(out); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	for _, expected := range []string{
+		`cdp_commands_in_flight{method="Page.navigate"} 1` + "\n",
+		`cdp_command_duration_seconds_bucket{method="Page.navigate",le="0.01"} 0` + "\n",
+		`cdp_command_duration_seconds_bucket{method="Page.navigate",le="0.025"} 1` + "\n",
+		`cdp_command_duration_seconds_sum{method="Page.navigate"} 0.02` + "\n",
+		`cdp_commands_total{method="Runtime.evaluate \"quoted\""} 1` + "\n",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("Expected the metrics to contain '%s', got:\n%s", strings.TrimSpace(expected), out.String())
+		}
+	}
+}
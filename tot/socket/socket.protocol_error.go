@@ -0,0 +1,100 @@
+package socket
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+/*
+JSON-RPC error codes returned by Chromium.
+*/
+const (
+	// CodeServerError is returned for failed commands, e.g. when a node
+	// isn't found or the target is closed.
+	CodeServerError = -32000
+
+	// CodeMethodNotFound is returned for unknown or unsupported methods.
+	CodeMethodNotFound = -32601
+
+	// CodeInvalidParams is returned for invalid command parameters.
+	CodeInvalidParams = -32602
+)
+
+/*
+Sentinel errors matching protocol errors with the corresponding code, e.g.
+
+	if errors.Is(result.Err, socket.ErrMethodNotFound) {
+		// Not supported by this browser version.
+	}
+*/
+var (
+	ErrServerError    = errors.New("protocol server error")
+	ErrMethodNotFound = errors.New("protocol method not found")
+	ErrInvalidParams  = errors.New("protocol invalid params")
+)
+
+/*
+ProtocolError is the error returned by a protocol command that failed in the
+browser. It is the Err value of command results and can be inspected with
+errors.As:
+
+	var protocolErr *socket.ProtocolError
+	if errors.As(result.Err, &protocolErr) {
+		fmt.Println(protocolErr.Code, protocolErr.Message)
+	}
+*/
+type ProtocolError struct {
+	// Code is the JSON-RPC error code.
+	Code int
+
+	// Data holds additional error information, if any.
+	Data json.RawMessage
+
+	// Message is the error message.
+	Message string
+
+	// Method is the name of the command or event that failed.
+	Method string
+}
+
+/*
+NewProtocolError returns the ProtocolError of a response error.
+*/
+func NewProtocolError(method string, err *Error) *ProtocolError {
+	protocolErr := &ProtocolError{
+		Code:    err.Code,
+		Data:    err.Data,
+		Message: err.Message,
+		Method:  method,
+	}
+	if "null" == string(protocolErr.Data) {
+		protocolErr.Data = nil
+	}
+	return protocolErr
+}
+
+/*
+Error implements error.
+*/
+func (err *ProtocolError) Error() string {
+	if 0 < len(err.Data) {
+		return fmt.Sprintf("%s: %s (code=%d, data=%s)", err.Method, err.Message, err.Code, err.Data)
+	}
+	return fmt.Sprintf("%s: %s (code=%d)", err.Method, err.Message, err.Code)
+}
+
+/*
+Is reports whether target is the sentinel error for the error code.
+*/
+func (err *ProtocolError) Is(target error) bool {
+	switch err.Code {
+	case CodeServerError:
+		return ErrServerError == target
+	case CodeMethodNotFound:
+		return ErrMethodNotFound == target
+	case CodeInvalidParams:
+		return ErrInvalidParams == target
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package socket
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+func TestProtocolError(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestProtocolError")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+
+	// The first Page.reload fails with a -32000 error.
+	result := <-soc.Page().Reload(&page.ReloadParams{})
+	var protocolErr *ProtocolError
+	if !errors.As(result.Err, &protocolErr) {
+		t.Fatalf("Expected a *ProtocolError, got %T", result.Err)
+	}
+	if CodeServerError != protocolErr.Code || "busy" != protocolErr.Message || "Page.reload" != protocolErr.Method {
+		t.Errorf("Expected a Page.reload server error, got %#v", protocolErr)
+	}
+	if !errors.Is(result.Err, ErrServerError) {
+		t.Errorf("Expected errors.Is to match ErrServerError")
+	}
+	if errors.Is(result.Err, ErrMethodNotFound) || errors.Is(result.Err, ErrInvalidParams) {
+		t.Errorf("Expected only ErrServerError to match")
+	}
+	if "Page.reload: busy (code=-32000)" != result.Err.Error() {
+		t.Errorf("Expected 'Page.reload: busy (code=-32000)', got '%s'", result.Err.Error())
+	}
+}
+
+func TestProtocolErrorIs(t *testing.T) {
+	for code, sentinel := range map[int]error{
+		CodeServerError:    ErrServerError,
+		CodeMethodNotFound: ErrMethodNotFound,
+		CodeInvalidParams:  ErrInvalidParams,
+	} {
+		err := NewProtocolError("Page.navigate", &Error{Code: code, Data: []byte(`"detail"`), Message: "failed"})
+		if !errors.Is(err, sentinel) {
+			t.Errorf("Expected code %d to match %v", code, sentinel)
+		}
+	}
+	err := NewProtocolError("Page.navigate", &Error{Code: 1, Message: "failed"})
+	if errors.Is(err, ErrServerError) {
+		t.Errorf("Expected code 1 not to match ErrServerError")
+	}
+}
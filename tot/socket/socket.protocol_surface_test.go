@@ -0,0 +1,50 @@
+package socket
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestSurface(t *testing.T) {
+	commandPattern := regexp.MustCompile(`NewCommand\(\s*protocol\.Socket,\s*"([A-Za-z]+\.[A-Za-z]+)"`)
+	eventPattern := regexp.MustCompile(`NewEventHandler\(\s*"([A-Za-z]+\.[A-Za-z]+)"`)
+
+	files, _ := filepath.Glob("cdtp.*.go")
+	methods := map[string]bool{}
+	events := map[string]bool{}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		source, err := ioutil.ReadFile(file)
+		if nil != err {
+			t.Fatalf("Expected nil, got error: %v", err)
+		}
+		for _, match := range commandPattern.FindAllStringSubmatch(string(source), -1) {
+			methods[match[1]] = true
+		}
+		for _, match := range eventPattern.FindAllStringSubmatch(string(source), -1) {
+			events[match[1]] = true
+		}
+	}
+
+	list := func(names map[string]bool) []string {
+		result := []string{}
+		for name := range names {
+			result = append(result, name)
+		}
+		sort.Strings(result)
+		return result
+	}
+	if !reflect.DeepEqual(list(methods), Surface.Methods) {
+		t.Errorf("Surface.Methods doesn't match the methods of the protocol wrappers")
+	}
+	if !reflect.DeepEqual(list(events), Surface.Events) {
+		t.Errorf("Surface.Events doesn't match the events of the protocol wrappers")
+	}
+}
@@ -0,0 +1,106 @@
+package socket
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+readQueueSize is the number of decoded messages the read loop buffers ahead of
+the message handlers.
+*/
+const readQueueSize = 64
+
+/*
+maxPooledBufferSize is the capacity above which read buffers are discarded
+instead of returned to the pool, so a single large message such as a screenshot
+doesn't pin its buffer.
+*/
+const maxPooledBufferSize = 1 * 1024 * 1024
+
+/*
+readBuffers holds the buffers messages are read into.
+*/
+var readBuffers = sync.Pool{
+	New: func() interface{} {
+		return &bytes.Buffer{}
+	},
+}
+
+/*
+readResponse reads the next message from a websocket connection.
+
+Connections that implement MessageReader are read into a pooled buffer. Only the
+message envelope, the ID, method, session ID and error, is decoded, the params
+and result are copied out of the buffer as raw JSON for the handlers to decode.
+Other connections are read with ReadJSON.
+*/
+func readResponse(conn WebSocketer) (*Response, error) {
+	response := &Response{}
+
+	reader, ok := conn.(MessageReader)
+	if !ok {
+		if err := conn.ReadJSON(response); nil != err {
+			return nil, err
+		}
+		return response, nil
+	}
+
+	buf := readBuffers.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer func() {
+		if buf.Cap() <= maxPooledBufferSize {
+			readBuffers.Put(buf)
+		}
+	}()
+
+	if err := reader.ReadMessage(buf); nil != err {
+		return nil, err
+	}
+	if err := json.Unmarshal(buf.Bytes(), response); nil != err {
+		return nil, codes.Wrap(err, codes.SocketReadFailed, "could not decode websocket message")
+	}
+	return response, nil
+}
+
+/*
+read is the read loop for a websocket connection. It runs in a single goroutine
+for the lifetime of the connection and sends the messages it reads to readCh
+until a read fails or done is closed. A read error is sent to errCh, which must
+be buffered, after all previously read messages have been sent.
+
+The loop is blocked in a read while waiting for a message, closing the
+connection ends it.
+*/
+func (socket *Socket) read(
+	conn WebSocketer,
+	readCh chan<- *Response,
+	errCh chan<- error,
+	done <-chan struct{},
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			err := codes.New(codes.SocketPanic, "recovered from panic in Socket.read()")
+			if e, ok := r.(error); ok {
+				err = codes.Wrap(e, codes.SocketPanic, "recovered from panic in Socket.read()")
+			}
+			errCh <- err
+		}
+	}()
+
+	for {
+		response, err := readResponse(conn)
+		if nil != err {
+			errCh <- err
+			return
+		}
+		select {
+		case readCh <- response:
+		case <-done:
+			return
+		}
+	}
+}
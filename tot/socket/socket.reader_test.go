@@ -0,0 +1,211 @@
+package socket
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+/*
+benchWebSocket delivers the same message a fixed number of times once it is
+started and then blocks until it is closed.
+*/
+type benchWebSocket struct {
+	closeOnce sync.Once
+	closed    chan struct{}
+	message   []byte
+	remaining int64
+	started   chan struct{}
+}
+
+func newBenchWebSocket(message []byte, count int) *benchWebSocket {
+	return &benchWebSocket{
+		closed:    make(chan struct{}),
+		message:   message,
+		remaining: int64(count),
+		started:   make(chan struct{}),
+	}
+}
+
+func (conn *benchWebSocket) Start() *benchWebSocket {
+	close(conn.started)
+	return conn
+}
+
+func (conn *benchWebSocket) Close() error {
+	conn.closeOnce.Do(func() { close(conn.closed) })
+	return nil
+}
+
+func (conn *benchWebSocket) next() error {
+	select {
+	case <-conn.started:
+	case <-conn.closed:
+		return errors.New("connection closed")
+	}
+	if atomic.AddInt64(&conn.remaining, -1) >= 0 {
+		return nil
+	}
+	<-conn.closed
+	return errors.New("connection closed")
+}
+
+func (conn *benchWebSocket) ReadJSON(v interface{}) error {
+	if err := conn.next(); nil != err {
+		return err
+	}
+	return json.Unmarshal(conn.message, v)
+}
+
+func (conn *benchWebSocket) ReadMessage(buf *bytes.Buffer) error {
+	if err := conn.next(); nil != err {
+		return err
+	}
+	_, err := buf.Write(conn.message)
+	return err
+}
+
+func (conn *benchWebSocket) WriteJSON(v interface{}) error {
+	return nil
+}
+
+/*
+jsonWebSocket hides the MessageReader implementation of a connection.
+*/
+type jsonWebSocket struct {
+	WebSocketer
+}
+
+func benchEvent() []byte {
+	message, _ := json.Marshal(&Response{
+		Method: "Network.dataReceived",
+		Params: []byte(`{"requestId":"1000.1","timestamp":1234.5,"dataLength":1024,"encodedDataLength":512,"data":"` + strings.Repeat("x", 1024) + `"}`),
+	})
+	return message
+}
+
+func TestReadResponse(t *testing.T) {
+	message := []byte(`{"id":0,"method":"Page.newEvent","params":{"value":1},"sessionId":"session-1"}`)
+	for name, conn := range map[string]WebSocketer{
+		"MessageReader": newBenchWebSocket(message, 2).Start(),
+		"ReadJSON":      &jsonWebSocket{newBenchWebSocket(message, 2).Start()},
+	} {
+		for a := 0; a < 2; a++ {
+			response, err := readResponse(conn)
+			if nil != err {
+				t.Fatalf("%s: expected nil, got error: %v", name, err)
+			}
+			if "Page.newEvent" != response.Method || "session-1" != response.SessionID {
+				t.Errorf("%s: expected a Page.newEvent event for session-1, got %s for '%s'", name, response.Method, response.SessionID)
+			}
+			if `{"value":1}` != string(response.Params) {
+				t.Errorf(`%s: expected '{"value":1}', got '%s'`, name, response.Params)
+			}
+		}
+	}
+
+	conn := newBenchWebSocket([]byte(`{"id":`), 1).Start()
+	if _, err := readResponse(conn); nil == err {
+		t.Errorf("Expected an error for an invalid message")
+	}
+}
+
+func TestListenStopLeaksNoGoroutines(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+
+	socketURL, _ := url.Parse("https://test:9222/TestListenStopLeaksNoGoroutines")
+	conn := newBenchWebSocket(benchEvent(), 100)
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	wg := &sync.WaitGroup{}
+	wg.Add(100)
+	soc.On("Network.dataReceived", func(params json.RawMessage) {
+		wg.Done()
+	})
+	conn.Start()
+	wg.Wait()
+	soc.Stop()
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > baseline {
+		if time.Now().After(deadline) {
+			buf := make([]byte, 1<<16)
+			t.Fatalf("Expected at most %d goroutines, got %d:\n%s", baseline, runtime.NumGoroutine(), buf[:runtime.Stack(buf, true)])
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+/*
+legacyListen is the read loop replaced by Socket.read, which started a goroutine
+for every read. It is kept to benchmark against.
+*/
+func legacyListen(socket *Socket, conn WebSocketer) error {
+	readCh := make(chan *Response)
+	errCh := make(chan error)
+	for {
+		go func() {
+			response := &Response{}
+			err := conn.ReadJSON(&response)
+			if nil != err {
+				errCh <- err
+			} else {
+				readCh <- response
+			}
+		}()
+
+		select {
+		case <-socket.ctx.Done():
+			return nil
+		case err := <-errCh:
+			return err
+		case response := <-readCh:
+			socket.middleware.receiver(socket.receive)(response)
+		}
+	}
+}
+
+func benchmarkListen(b *testing.B, legacy bool) {
+	socketURL, _ := url.Parse("https://test:9222/BenchmarkListen")
+	conn := newBenchWebSocket(benchEvent(), b.N)
+	idle := newBenchWebSocket(nil, 0)
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		if legacy {
+			return idle, nil
+		}
+		return conn, nil
+	}))
+	defer soc.Stop()
+	defer conn.Close()
+
+	wg := &sync.WaitGroup{}
+	wg.Add(b.N)
+	soc.On("Network.dataReceived", func(params json.RawMessage) {
+		wg.Done()
+	})
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(conn.message)))
+	b.ResetTimer()
+	if legacy {
+		go legacyListen(soc, conn)
+	}
+	conn.Start()
+	wg.Wait()
+}
+
+func BenchmarkListen(b *testing.B) {
+	benchmarkListen(b, false)
+}
+
+func BenchmarkListenLegacy(b *testing.B) {
+	benchmarkListen(b, true)
+}
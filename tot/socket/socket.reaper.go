@@ -0,0 +1,65 @@
+package socket
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/logger"
+)
+
+/*
+reap periodically fails any pending command that has waited longer than the
+socket command timeout for a response. It runs until the socket is stopped.
+*/
+func (socket *Socket) reap() {
+	defer socket.wg.Done()
+
+	ticker := time.NewTicker(reapInterval(socket.commandTimeout))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-socket.ctx.Done():
+			return
+
+		case now := <-ticker.C:
+			for _, pending := range socket.commands.Pending() {
+				if now.Sub(pending.Sent) < socket.commandTimeout {
+					continue
+				}
+				// The command may have been answered since Pending() was
+				// called, Pop() ensures only one response is delivered.
+				command, err := socket.commands.Pop(pending.ID)
+				if nil != err {
+					continue
+				}
+				socket.expireCommand(command, now.Sub(pending.Sent))
+			}
+		}
+	}
+}
+
+/*
+expireCommand fails a command that did not receive a response in time.
+*/
+func (socket *Socket) expireCommand(command Commander, age time.Duration) {
+	err := codes.New(codes.SocketCommandTimeout, fmt.Sprintf("command #%d '%s' timed out after %s", command.ID(), command.Method(), age))
+	socket.logger.Warn("command timed out", logger.Fields{"commandID": command.ID(), "error": err, "method": command.Method(), "socketID": socket.socketID})
+	socket.failCommand(command, codes.SocketCommandTimeout, err)
+}
+
+/*
+reapInterval returns how often the command stack is checked for expired
+commands, a fraction of the timeout bounded to between 10ms and 1s.
+*/
+func reapInterval(timeout time.Duration) time.Duration {
+	interval := timeout / 10
+	if interval < 10*time.Millisecond {
+		interval = 10 * time.Millisecond
+	}
+	if interval > time.Second {
+		interval = time.Second
+	}
+	return interval
+}
@@ -0,0 +1,211 @@
+package socket
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/logger"
+)
+
+/*
+ConnectionState describes the state of a socket's websocket connection.
+*/
+type ConnectionState int
+
+const (
+	// StateConnecting is emitted before each connection attempt.
+	StateConnecting ConnectionState = iota
+	// StateConnected is emitted when a connection has been established.
+	StateConnected
+	// StateLost is emitted when an established connection fails.
+	StateLost
+	// StateGaveUp is emitted when no further connection attempts will be
+	// made.
+	StateGaveUp
+)
+
+/*
+String implements Stringer.
+*/
+func (state ConnectionState) String() string {
+	switch state {
+	case StateConnecting:
+		return "connecting"
+	case StateConnected:
+		return "connected"
+	case StateLost:
+		return "lost"
+	case StateGaveUp:
+		return "gave up"
+	}
+	return fmt.Sprintf("ConnectionState(%d)", int(state))
+}
+
+/*
+ConnectionStateEvent is delivered to connection state handlers whenever the
+socket connection state changes.
+*/
+type ConnectionStateEvent struct {
+	// State is the new connection state.
+	State ConnectionState
+
+	// Attempt is the reconnection attempt number, starting at 1. It is 0 for
+	// the initial connection.
+	Attempt int
+
+	// Err is the error that caused the state change, if any.
+	Err error
+}
+
+/*
+ReconnectPolicy defines how a socket re-dials a lost websocket connection. Zero
+values are replaced with sane defaults.
+*/
+type ReconnectPolicy struct {
+	// Optional. MaxAttempts is the number of reconnection attempts made
+	// before giving up. Defaults to 0, which retries until the socket is
+	// stopped.
+	MaxAttempts int
+
+	// Optional. InitialDelay is the delay before the first reconnection
+	// attempt. Defaults to 100ms.
+	InitialDelay time.Duration
+
+	// Optional. MaxDelay is the maximum delay between reconnection attempts.
+	// Defaults to 30s.
+	MaxDelay time.Duration
+
+	// Optional. Multiplier is the factor the delay grows by after each failed
+	// attempt. Defaults to 2.
+	Multiplier float64
+}
+
+/*
+Delay returns the backoff delay before the specified reconnection attempt.
+*/
+func (policy *ReconnectPolicy) Delay(attempt int) time.Duration {
+	initial := policy.InitialDelay
+	if initial <= 0 {
+		initial = 100 * time.Millisecond
+	}
+	maxDelay := policy.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = 30 * time.Second
+	}
+	multiplier := policy.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := float64(initial) * math.Pow(multiplier, float64(attempt-1))
+	if delay > float64(maxDelay) {
+		return maxDelay
+	}
+	return time.Duration(delay)
+}
+
+/*
+OnConnectionState adds a handler that is called each time the socket connection
+state changes. Handlers are called synchronously from the socket listener in
+the order the state changes occur and must not block.
+*/
+func (socket *Socket) OnConnectionState(
+	callback func(event *ConnectionStateEvent),
+) {
+	socket.stateMux.Lock()
+	socket.stateHandlers = append(socket.stateHandlers, callback)
+	socket.stateMux.Unlock()
+}
+
+/*
+emitConnectionState delivers a connection state change to all registered
+connection state handlers.
+*/
+func (socket *Socket) emitConnectionState(event *ConnectionStateEvent) {
+	socket.logger.Debug("connection state changed", logger.Fields{"attempt": event.Attempt, "error": event.Err, "socketID": socket.socketID, "state": event.State.String()})
+
+	socket.stateMux.Lock()
+	handlers := make([]func(event *ConnectionStateEvent), len(socket.stateHandlers))
+	copy(handlers, socket.stateHandlers)
+	socket.stateMux.Unlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
+
+/*
+connectionLost closes the failed connection and fails all in-flight commands.
+*/
+func (socket *Socket) connectionLost(err error) {
+	socket.logger.Warn("websocket connection lost", logger.Fields{"error": err, "socketID": socket.socketID, "url": socket.url.String()})
+	socket.emitConnectionState(&ConnectionStateEvent{State: StateLost, Err: err})
+	socket.Disconnect()
+
+	for _, pending := range socket.commands.Pending() {
+		command, popErr := socket.commands.Pop(pending.ID)
+		if nil != popErr {
+			continue
+		}
+		socket.failCommand(command, codes.SocketConnectionLost, codes.Wrap(
+			err,
+			codes.SocketConnectionLost,
+			fmt.Sprintf("connection lost while waiting for command #%d '%s'", command.ID(), command.Method()),
+		))
+	}
+}
+
+/*
+reconnect re-dials the websocket connection according to the reconnect policy.
+Once connected, the enabled protocol domains are restored in the background. A
+nil error is returned if the socket is stopped while reconnecting.
+*/
+func (socket *Socket) reconnect() error {
+	var err error
+	for attempt := 1; 0 == socket.reconnectPolicy.MaxAttempts || attempt <= socket.reconnectPolicy.MaxAttempts; attempt++ {
+		select {
+		case <-socket.ctx.Done():
+			return nil
+		case <-time.After(socket.reconnectPolicy.Delay(attempt)):
+		}
+
+		socket.emitConnectionState(&ConnectionStateEvent{State: StateConnecting, Attempt: attempt})
+		if err = socket.Connect(); nil != err {
+			socket.logger.Debug("reconnection attempt failed", logger.Fields{"attempt": attempt, "error": err, "socketID": socket.socketID})
+			continue
+		}
+
+		socket.emitConnectionState(&ConnectionStateEvent{State: StateConnected, Attempt: attempt})
+		go func() {
+			socket.replayDomains(socket, socket.domains, "")
+			socket.reattachSessions()
+		}()
+		return nil
+	}
+
+	err = codes.Wrap(err, codes.SocketReconnectFailed, fmt.Sprintf("could not reconnect after %d attempts", socket.reconnectPolicy.MaxAttempts))
+	socket.emitConnectionState(&ConnectionStateEvent{State: StateGaveUp, Attempt: socket.reconnectPolicy.MaxAttempts, Err: err})
+	return err
+}
+
+/*
+replayDomains replays the enable commands of the domains tracked by tracker
+through soc, the socket or one of its sessions, after the connection was
+re-established. The replayed commands don't add references.
+*/
+func (socket *Socket) replayDomains(soc Socketer, tracker *domainTracker, sessionID string) {
+	for _, enabled := range tracker.list() {
+		method := enabled.Domain + ".enable"
+		if err := call(socket.ctx, soc, method, enabled.Params, nil); nil != err {
+			socket.logger.Warn("could not restore protocol domain", logger.Fields{"error": err, "method": method, "sessionID": sessionID, "socketID": socket.socketID})
+			continue
+		}
+		tracker.addRefs(enabled.Domain, -1)
+		socket.logger.Debug("restored protocol domain", logger.Fields{"method": method, "sessionID": sessionID, "socketID": socket.socketID})
+	}
+}
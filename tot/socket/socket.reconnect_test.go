@@ -0,0 +1,165 @@
+package socket
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	std "github.com/bdlm/std/error"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+droppingWebSocket is a WebSocketer that replays queued responses and returns a
+read error once the queue has been drained and drop has been called.
+*/
+type droppingWebSocket struct {
+	mux       sync.Mutex
+	dropped   bool
+	responses []*Response
+	written   []string
+}
+
+func (socket *droppingWebSocket) Close() error { return nil }
+
+func (socket *droppingWebSocket) drop() {
+	socket.mux.Lock()
+	socket.dropped = true
+	socket.mux.Unlock()
+}
+
+func (socket *droppingWebSocket) ReadJSON(v interface{}) error {
+	for ; ; time.Sleep(10 * time.Millisecond) {
+		socket.mux.Lock()
+		if len(socket.responses) > 0 {
+			data, _ := json.Marshal(socket.responses[0])
+			socket.responses = socket.responses[1:]
+			socket.mux.Unlock()
+			return json.Unmarshal(data, v)
+		}
+		if socket.dropped {
+			socket.mux.Unlock()
+			return fmt.Errorf("connection reset by peer")
+		}
+		socket.mux.Unlock()
+	}
+}
+
+func (socket *droppingWebSocket) WriteJSON(v interface{}) error {
+	payload := v.(*Payload)
+	socket.mux.Lock()
+	socket.written = append(socket.written, payload.Method)
+	// Acknowledge every command.
+	socket.responses = append(socket.responses, &Response{ID: payload.ID, Result: []byte(`{}`)})
+	socket.mux.Unlock()
+	return nil
+}
+
+func TestReconnectPolicyDelay(t *testing.T) {
+	policy := &ReconnectPolicy{
+		InitialDelay: 100 * time.Millisecond,
+		MaxDelay:     time.Second,
+	}
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+	}
+	for a, delay := range expected {
+		if result := policy.Delay(a + 1); delay != result {
+			t.Errorf("Expected %s delay for attempt %d, got %s", delay, a+1, result)
+		}
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestReconnect")
+	mockSocket := NewMock(socketURL, WithReconnect(&ReconnectPolicy{
+		InitialDelay: 10 * time.Millisecond,
+	}))
+
+	connections := make([]*droppingWebSocket, 0)
+	connectionsMux := &sync.Mutex{}
+	mockSocket.newSocket = func(socketURL *url.URL) (WebSocketer, error) {
+		connectionsMux.Lock()
+		defer connectionsMux.Unlock()
+		conn := &droppingWebSocket{}
+		connections = append(connections, conn)
+		return conn, nil
+	}
+
+	states := make(chan ConnectionState, 10)
+	mockSocket.OnConnectionState(func(event *ConnectionStateEvent) {
+		states <- event.State
+	})
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	result := <-mockSocket.Page().Enable(nil)
+	if nil != result.Err {
+		t.Fatalf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	connectionsMux.Lock()
+	connections[0].drop()
+	connectionsMux.Unlock()
+
+	expected := []ConnectionState{StateConnecting, StateConnected, StateLost, StateConnecting, StateConnected}
+	for _, state := range expected {
+		select {
+		case result := <-states:
+			if state != result {
+				t.Fatalf("Expected state '%s', got '%s'", state, result)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for state '%s'", state)
+		}
+	}
+
+	// The enabled Page domain is restored on the new connection.
+	for a := 0; a < 100; a++ {
+		connectionsMux.Lock()
+		conn := connections[len(connections)-1]
+		connectionsMux.Unlock()
+		conn.mux.Lock()
+		written := conn.written
+		conn.mux.Unlock()
+		if 1 == len(written) && "Page.enable" == written[0] {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("Expected Page.enable to be replayed after reconnecting")
+}
+
+func TestReconnectFailsInFlightCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestReconnectFailsInFlightCommands")
+	mockSocket := NewMock(socketURL, WithReconnect(&ReconnectPolicy{
+		InitialDelay: 10 * time.Millisecond,
+		MaxAttempts:  1,
+	}))
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	command := NewCommand(mockSocket, "Some.method", nil)
+	resultChan := mockSocket.SendCommand(command)
+	for 0 == len(mockSocket.Pending()) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	mockSocket.connectionLost(fmt.Errorf("connection reset by peer"))
+
+	select {
+	case <-resultChan:
+		err, ok := command.Error().(interface{ Code() std.Code })
+		if !ok || codes.SocketConnectionLost != err.Code() {
+			t.Errorf("Expected a codes.SocketConnectionLost error, got '%v'", command.Error())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for the command to fail")
+	}
+}
@@ -0,0 +1,157 @@
+package socket
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"sync"
+	"time"
+)
+
+/*
+Transcript directions.
+*/
+const (
+	// DirectionSend marks a message written to the browser.
+	DirectionSend = "send"
+
+	// DirectionReceive marks a message read from the browser.
+	DirectionReceive = "receive"
+)
+
+/*
+TranscriptEntry is a single line of a websocket transcript.
+*/
+type TranscriptEntry struct {
+	// Connection identifies the recorded connection of the message.
+	// Connections opened by RecordWebsocket are numbered from 1 in the order
+	// they were opened, it is 0 for a single NewRecordingWebsocket connection.
+	Connection int `json:"connection,omitempty"`
+
+	// Direction is DirectionSend or DirectionReceive.
+	Direction string `json:"direction"`
+
+	// Message is the JSON message.
+	Message json.RawMessage `json:"message"`
+
+	// Time is the time the message was written or read.
+	Time time.Time `json:"time"`
+
+	// URL is the URL of the recorded connection, if known.
+	URL string `json:"url,omitempty"`
+}
+
+/*
+NewRecordingWebsocket returns a WebSocketer that passes all messages through to
+conn and writes each one to transcript as a JSONL TranscriptEntry. Transcripts
+can be served back with NewReplayWebsocket.
+*/
+func NewRecordingWebsocket(conn WebSocketer, transcript io.Writer) *RecordingWebSocket {
+	return &RecordingWebSocket{
+		conn:       conn,
+		encoder:    json.NewEncoder(transcript),
+		encoderMux: &sync.Mutex{},
+	}
+}
+
+/*
+RecordWebsocket wraps a websocket constructor so that every connection it opens
+is recorded to transcript, e.g.
+
+	socket.WithWebSocket(socket.RecordWebsocket(socket.NewWebsocket, file))
+
+Each entry carries the number and URL of its connection so the connections can
+be replayed separately.
+*/
+func RecordWebsocket(
+	newSocket func(socketURL *url.URL) (WebSocketer, error),
+	transcript io.Writer,
+) func(socketURL *url.URL) (WebSocketer, error) {
+	encoderMux := &sync.Mutex{}
+	encoder := json.NewEncoder(transcript)
+	connections := 0
+	return func(socketURL *url.URL) (WebSocketer, error) {
+		conn, err := newSocket(socketURL)
+		if nil != err {
+			return nil, err
+		}
+		encoderMux.Lock()
+		connections++
+		connection := connections
+		encoderMux.Unlock()
+		return &RecordingWebSocket{
+			conn:       conn,
+			connection: connection,
+			encoder:    encoder,
+			encoderMux: encoderMux,
+			url:        socketURL.String(),
+		}, nil
+	}
+}
+
+/*
+RecordingWebSocket provides a WebSocketer interface that records the messages of
+another WebSocketer.
+
+RecordingWebSocket represents a WebSocketer interface
+*/
+type RecordingWebSocket struct {
+	conn       WebSocketer
+	connection int
+	encoder    *json.Encoder
+	encoderMux *sync.Mutex
+	url        string
+}
+
+/*
+Close closes the recorded connection.
+
+Close is a WebSocketer implementation.
+*/
+func (socket *RecordingWebSocket) Close() error {
+	return socket.conn.Close()
+}
+
+/*
+ReadJSON reads the next message from the recorded connection and writes it to
+the transcript.
+
+ReadJSON is a WebSocketer implementation.
+*/
+func (socket *RecordingWebSocket) ReadJSON(v interface{}) error {
+	if err := socket.conn.ReadJSON(v); nil != err {
+		return err
+	}
+	return socket.record(DirectionReceive, v)
+}
+
+/*
+WriteJSON writes the message to the transcript and to the recorded connection.
+
+WriteJSON is a WebSocketer implementation.
+*/
+func (socket *RecordingWebSocket) WriteJSON(v interface{}) error {
+	if err := socket.record(DirectionSend, v); nil != err {
+		return err
+	}
+	return socket.conn.WriteJSON(v)
+}
+
+/*
+record writes a transcript entry for a message.
+*/
+func (socket *RecordingWebSocket) record(direction string, v interface{}) error {
+	message, err := json.Marshal(v)
+	if nil != err {
+		return err
+	}
+	socket.encoderMux.Lock()
+	defer socket.encoderMux.Unlock()
+	return socket.encoder.Encode(&TranscriptEntry{
+		Connection: socket.connection,
+		Direction:  direction,
+		Message:    message,
+		Time:       time.Now(),
+		URL:        socket.url,
+	})
+}
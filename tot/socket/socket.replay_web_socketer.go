@@ -0,0 +1,294 @@
+package socket
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"sync"
+
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+NewReplayWebsocket returns a WebSocketer that serves a transcript written by a
+RecordingWebSocket, allowing a recorded session to run without a browser.
+
+Each recorded connection is replayed separately, see Dial. Commands written to
+a connection are matched to its recorded commands by method, params and
+session ID rather than by command ID, and the IDs of the recorded responses are
+rewritten to the IDs of the matched commands. Recorded messages are read back
+in order once all of the commands recorded before them have been matched.
+Commands that match no recorded command fail with a
+codes.WebsocketReplayMismatch error.
+*/
+func NewReplayWebsocket(transcript io.Reader) (*ReplayWebSocket, error) {
+	replay := &ReplayWebSocket{
+		mux: &sync.Mutex{},
+	}
+	connections := map[int]*replayConnection{}
+
+	scanner := bufio.NewScanner(transcript)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if 0 == len(scanner.Bytes()) {
+			continue
+		}
+		entry := &TranscriptEntry{}
+		if err := json.Unmarshal(scanner.Bytes(), entry); nil != err {
+			return nil, codes.Wrap(err, codes.WebsocketTranscriptInvalid, fmt.Sprintf("invalid transcript entry on line %d", line))
+		}
+		message := &replayMessage{entry: entry}
+		if err := json.Unmarshal(entry.Message, &message.payload); nil != err {
+			return nil, codes.Wrap(err, codes.WebsocketTranscriptInvalid, fmt.Sprintf("invalid transcript message on line %d", line))
+		}
+		conn, ok := connections[entry.Connection]
+		if !ok {
+			conn = newReplayConnection(entry.URL)
+			connections[entry.Connection] = conn
+			replay.connections = append(replay.connections, conn)
+		}
+		conn.messages = append(conn.messages, message)
+	}
+	if err := scanner.Err(); nil != err {
+		return nil, codes.Wrap(err, codes.WebsocketTranscriptInvalid, "could not read transcript")
+	}
+	if 0 == len(replay.connections) {
+		replay.connections = append(replay.connections, newReplayConnection(""))
+	}
+
+	return replay, nil
+}
+
+/*
+ReplayWebSocket provides a WebSocketer interface that serves a recorded
+transcript. Used directly, it serves the first recorded connection.
+
+ReplayWebSocket represents a WebSocketer interface
+*/
+type ReplayWebSocket struct {
+	connections []*replayConnection
+	mux         *sync.Mutex
+}
+
+/*
+replayConnection serves the messages of a single recorded connection.
+*/
+type replayConnection struct {
+	closed   bool
+	cond     *sync.Cond
+	dialed   bool
+	ids      map[int]int
+	messages []*replayMessage
+	mux      *sync.Mutex
+	next     int
+	url      string
+}
+
+/*
+newReplayConnection returns an empty replay connection for a recorded URL.
+*/
+func newReplayConnection(socketURL string) *replayConnection {
+	mux := &sync.Mutex{}
+	return &replayConnection{
+		cond: sync.NewCond(mux),
+		ids:  make(map[int]int),
+		mux:  mux,
+		url:  socketURL,
+	}
+}
+
+/*
+replayMessage is a transcript entry and its decoded message.
+*/
+type replayMessage struct {
+	entry   *TranscriptEntry
+	matched bool
+	payload struct {
+		ID        int             `json:"id"`
+		Method    string          `json:"method"`
+		Params    json.RawMessage `json:"params"`
+		SessionID string          `json:"sessionId"`
+	}
+}
+
+/*
+Dial returns the next recorded connection to the path of socketURL, so
+reconnects and the connections of other targets replay their own messages.
+The host is ignored as ports usually differ between runs. If no connection was
+recorded for the path the next connection that hasn't been dialed yet is
+returned, e.g. for transcripts recorded without URLs or for targets with
+generated IDs. Dial can be passed to WithWebSocket.
+*/
+func (socket *ReplayWebSocket) Dial(socketURL *url.URL) (WebSocketer, error) {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+
+	var next *replayConnection
+	for _, conn := range socket.connections {
+		if conn.dialed {
+			continue
+		}
+		if recordedURL, err := url.Parse(conn.url); nil == err && "" != conn.url && recordedURL.Path == socketURL.Path {
+			next = conn
+			break
+		}
+		if nil == next {
+			next = conn
+		}
+	}
+	if nil == next {
+		return nil, codes.New(codes.WebsocketReplayMismatch, fmt.Sprintf("no recorded connection left for '%s'", socketURL))
+	}
+	next.dialed = true
+	return next, nil
+}
+
+/*
+Close stops the replay of every connection. Pending and subsequent reads fail.
+
+Close is a WebSocketer implementation.
+*/
+func (socket *ReplayWebSocket) Close() error {
+	for _, conn := range socket.connections {
+		conn.Close()
+	}
+	return nil
+}
+
+/*
+ReadJSON reads the next message of the first recorded connection, see
+replayConnection.ReadJSON.
+
+ReadJSON is a WebSocketer implementation.
+*/
+func (socket *ReplayWebSocket) ReadJSON(v interface{}) error {
+	return socket.connections[0].ReadJSON(v)
+}
+
+/*
+WriteJSON matches a command to the first recorded connection, see
+replayConnection.WriteJSON.
+
+WriteJSON is a WebSocketer implementation.
+*/
+func (socket *ReplayWebSocket) WriteJSON(v interface{}) error {
+	return socket.connections[0].WriteJSON(v)
+}
+
+/*
+Close stops the replay of the connection. Pending and subsequent reads fail.
+
+Close is a WebSocketer implementation.
+*/
+func (conn *replayConnection) Close() error {
+	conn.mux.Lock()
+	defer conn.mux.Unlock()
+	conn.closed = true
+	conn.cond.Broadcast()
+	return nil
+}
+
+/*
+ReadJSON blocks until the next recorded message is due and unmarshalls it into
+the provided variable. When the transcript is exhausted ReadJSON blocks until
+the replay is closed.
+
+ReadJSON is a WebSocketer implementation.
+*/
+func (conn *replayConnection) ReadJSON(v interface{}) error {
+	conn.mux.Lock()
+	defer conn.mux.Unlock()
+
+	for {
+		if conn.closed {
+			return codes.New(codes.WebsocketNotConnected, "replay closed")
+		}
+		if message := conn.due(); nil != message {
+			data := message.entry.Message
+			if id, ok := conn.ids[message.payload.ID]; ok && 0 < message.payload.ID {
+				fields := map[string]json.RawMessage{}
+				if err := json.Unmarshal(data, &fields); nil != err {
+					return err
+				}
+				fields["id"], _ = json.Marshal(id)
+				data, _ = json.Marshal(fields)
+			}
+			return json.Unmarshal(data, v)
+		}
+		conn.cond.Wait()
+	}
+}
+
+/*
+WriteJSON matches a command to the first unmatched recorded command with the
+same method, params and session ID.
+
+WriteJSON is a WebSocketer implementation.
+*/
+func (conn *replayConnection) WriteJSON(v interface{}) error {
+	data, err := json.Marshal(v)
+	if nil != err {
+		return err
+	}
+	command := &replayMessage{}
+	if err := json.Unmarshal(data, &command.payload); nil != err {
+		return err
+	}
+
+	conn.mux.Lock()
+	defer conn.mux.Unlock()
+	for _, message := range conn.messages {
+		if DirectionSend != message.entry.Direction || message.matched {
+			continue
+		}
+		if message.payload.Method == command.payload.Method &&
+			message.payload.SessionID == command.payload.SessionID &&
+			equalJSON(message.payload.Params, command.payload.Params) {
+			message.matched = true
+			conn.ids[message.payload.ID] = command.payload.ID
+			conn.cond.Broadcast()
+			return nil
+		}
+	}
+	return codes.New(codes.WebsocketReplayMismatch, fmt.Sprintf("no recorded command matches '%s' %s", command.payload.Method, command.payload.Params))
+}
+
+/*
+due returns the next recorded message if all of the commands recorded before it
+have been matched. The caller must hold the mutex.
+*/
+func (conn *replayConnection) due() *replayMessage {
+	for ; conn.next < len(conn.messages); conn.next++ {
+		message := conn.messages[conn.next]
+		if DirectionSend != message.entry.Direction {
+			conn.next++
+			return message
+		}
+		if !message.matched {
+			return nil
+		}
+	}
+	return nil
+}
+
+/*
+equalJSON returns whether two JSON documents are equivalent. Missing and null
+documents are equal.
+*/
+func equalJSON(a, b json.RawMessage) bool {
+	var aValue, bValue interface{}
+	if 0 < len(a) {
+		if err := json.Unmarshal(a, &aValue); nil != err {
+			return false
+		}
+	}
+	if 0 < len(b) {
+		if err := json.Unmarshal(b, &bValue); nil != err {
+			return false
+		}
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
@@ -0,0 +1,214 @@
+package socket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	std "github.com/bdlm/std/error"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+navigateWebSocket answers Page.navigate and then fires Page.loadEventFired.
+*/
+type navigateWebSocket struct {
+	closed    chan struct{}
+	responses chan *Response
+}
+
+func (conn *navigateWebSocket) Close() error {
+	close(conn.closed)
+	return nil
+}
+
+func (conn *navigateWebSocket) ReadJSON(v interface{}) error {
+	select {
+	case response := <-conn.responses:
+		data, _ := json.Marshal(response)
+		return json.Unmarshal(data, v)
+	case <-conn.closed:
+		return errors.New("connection closed")
+	}
+}
+
+func (conn *navigateWebSocket) WriteJSON(v interface{}) error {
+	payload := v.(*Payload)
+	conn.responses <- &Response{ID: payload.ID, Result: []byte(`{"frameId":"frame-1"}`)}
+	conn.responses <- &Response{Method: "Page.loadEventFired", Params: []byte(`{"timestamp":1}`)}
+	return nil
+}
+
+func navigate(t *testing.T, soc *Socket) {
+	loaded := make(chan *page.LoadEventFiredEvent, 1)
+	soc.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		loaded <- event
+	})
+
+	result := <-soc.Page().Navigate(&page.NavigateParams{URL: "https://example.com/"})
+	if nil != result.Err {
+		t.Fatalf("Expected nil, got error: %v", result.Err)
+	}
+	if "frame-1" != result.FrameID {
+		t.Errorf("Expected 'frame-1', got '%s'", result.FrameID)
+	}
+	select {
+	case event := <-loaded:
+		if 1 != event.Timestamp {
+			t.Errorf("Expected timestamp 1, got %v", event.Timestamp)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected a Page.loadEventFired event")
+	}
+}
+
+func TestRecordAndReplayWebSocket(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestRecordAndReplayWebSocket")
+	transcript := &bytes.Buffer{}
+
+	recorder := New(socketURL, WithWebSocket(RecordWebsocket(
+		func(*url.URL) (WebSocketer, error) {
+			return &navigateWebSocket{
+				closed:    make(chan struct{}),
+				responses: make(chan *Response, 2),
+			}, nil
+		},
+		transcript,
+	)))
+	navigate(t, recorder)
+	recorder.Stop()
+
+	lines := strings.Split(strings.TrimSpace(transcript.String()), "\n")
+	if 3 != len(lines) {
+		t.Fatalf("Expected 3 transcript entries, got %d", len(lines))
+	}
+	entry := &TranscriptEntry{}
+	if err := json.Unmarshal([]byte(lines[0]), entry); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if DirectionSend != entry.Direction || entry.Time.IsZero() {
+		t.Errorf("Expected a timestamped send entry, got %s", lines[0])
+	}
+
+	replay, err := NewReplayWebsocket(strings.NewReader(transcript.String()))
+	if nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	replayer := New(socketURL, WithWebSocket(replay.Dial))
+	defer replayer.Stop()
+
+	// The replayed command IDs differ from the recorded ones.
+	replayer.NextCommandID()
+	replayer.NextCommandID()
+	navigate(t, replayer)
+
+	err = replay.WriteJSON(&Payload{ID: 10, Method: "Page.reload"})
+	if err, ok := err.(interface{ Code() std.Code }); !ok || codes.WebsocketReplayMismatch != err.Code() {
+		t.Errorf("Expected a WebsocketReplayMismatch error, got '%v'", err)
+	}
+}
+
+/*
+pathWebSocket answers every command with the path of its URL.
+*/
+type pathWebSocket struct {
+	closed    chan struct{}
+	path      string
+	responses chan *Response
+}
+
+func (conn *pathWebSocket) Close() error {
+	close(conn.closed)
+	return nil
+}
+
+func (conn *pathWebSocket) ReadJSON(v interface{}) error {
+	select {
+	case response := <-conn.responses:
+		data, _ := json.Marshal(response)
+		return json.Unmarshal(data, v)
+	case <-conn.closed:
+		return errors.New("connection closed")
+	}
+}
+
+func (conn *pathWebSocket) WriteJSON(v interface{}) error {
+	result, _ := json.Marshal(map[string]string{"path": conn.path})
+	conn.responses <- &Response{ID: v.(*Payload).ID, Result: result}
+	return nil
+}
+
+func TestRecordAndReplayConnections(t *testing.T) {
+	transcript := &bytes.Buffer{}
+	dial := RecordWebsocket(
+		func(socketURL *url.URL) (WebSocketer, error) {
+			return &pathWebSocket{
+				closed:    make(chan struct{}),
+				path:      socketURL.Path,
+				responses: make(chan *Response, 1),
+			}, nil
+		},
+		transcript,
+	)
+	callPath := func(soc *Socket) string {
+		result := struct {
+			Path string `json:"path"`
+		}{}
+		if err := soc.Call(context.Background(), "Some.method", nil, &result); nil != err {
+			t.Fatalf("Expected nil, got error: %v", err)
+		}
+		return result.Path
+	}
+
+	browserURL, _ := url.Parse("ws://localhost:9222/devtools/browser/1")
+	pageURL, _ := url.Parse("ws://localhost:9222/devtools/page/1")
+	browser := New(browserURL, WithWebSocket(dial))
+	tab := New(pageURL, WithWebSocket(dial))
+	callPath(browser)
+	callPath(tab)
+	browser.Stop()
+	tab.Stop()
+
+	entry := &TranscriptEntry{}
+	lines := strings.Split(strings.TrimSpace(transcript.String()), "\n")
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), entry); nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	if 2 != entry.Connection || pageURL.String() != entry.URL {
+		t.Errorf("Expected connection 2 for '%s', got %d for '%s'", pageURL, entry.Connection, entry.URL)
+	}
+
+	// The connections are replayed separately, whatever the dial order and
+	// host.
+	replay, err := NewReplayWebsocket(strings.NewReader(transcript.String()))
+	if nil != err {
+		t.Fatalf("Expected nil, got error: %v", err)
+	}
+	pageURL.Host = "localhost:9333"
+	tab = New(pageURL, WithWebSocket(replay.Dial))
+	defer tab.Stop()
+	browser = New(browserURL, WithWebSocket(replay.Dial))
+	defer browser.Stop()
+	if path := callPath(tab); "/devtools/page/1" != path {
+		t.Errorf("Expected '/devtools/page/1', got '%s'", path)
+	}
+	if path := callPath(browser); "/devtools/browser/1" != path {
+		t.Errorf("Expected '/devtools/browser/1', got '%s'", path)
+	}
+
+	if _, err := replay.Dial(pageURL); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+}
+
+func TestReplayWebSocketInvalidTranscript(t *testing.T) {
+	if _, err := NewReplayWebsocket(strings.NewReader("not json\n")); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+}
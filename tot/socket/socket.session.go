@@ -0,0 +1,368 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/logger"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+Session is a Socketer and Protocoller bound to a target session that is attached
+over a shared browser websocket connection.
+
+Sessions use the flattened session protocol: commands are sent through the
+browser connection with the session ID and events that carry the session ID are
+delivered to the session's event handlers. A single browser connection can
+drive any number of pages, workers and frames this way.
+
+The connection methods delegate to the browser connection. When the browser
+connection is re-established by its reconnect policy, sessions created by
+AttachToTarget are attached to their targets again, their session ID changes
+and their enabled domains are restored. Other sessions are stopped.
+*/
+type Session struct {
+	*Socket
+	id       string
+	parent   *Socket
+	targetID string
+}
+
+/*
+AttachToTarget attaches to the specified target in flattened session mode and
+returns a Session bound to it.
+*/
+func (socket *Socket) AttachToTarget(ctx context.Context, targetID string) (*Session, error) {
+	result := <-socket.Target().AttachToTargetContext(ctx, &target.AttachToTargetParams{
+		TargetID: target.TargetID(targetID),
+		Flatten:  true,
+	})
+	if nil != result.Err {
+		return nil, codes.Wrap(result.Err, codes.SocketSessionAttachFailed, fmt.Sprintf("could not attach to target '%s'", targetID))
+	}
+	session := socket.Session(string(result.SessionID))
+	socket.sessionsMux.Lock()
+	session.targetID = targetID
+	socket.sessionsMux.Unlock()
+	return session, nil
+}
+
+/*
+Session returns a Session bound to an attached session ID, for example one
+reported by a Target.attachedToTarget event. The same Session is returned until
+it is stopped or detached.
+*/
+func (socket *Socket) Session(sessionID string) *Session {
+	socket.sessionsMux.Lock()
+	defer socket.sessionsMux.Unlock()
+
+	if session, ok := socket.sessions[sessionID]; ok {
+		return session
+	}
+
+	ctx, cancel := context.WithCancel(socket.ctx)
+	session := &Session{
+		Socket: &Socket{
+			commandIDMux: &sync.Mutex{},
+			commands:     NewCommandMap(),
+			domains:      newDomainTracker(),
+			events:       socket.events,
+			handlers:     NewEventHandlerMap(),
+			logger:       socket.logger,
+			middleware:   &middleware{mux: &sync.Mutex{}, parent: socket.middleware},
+			mux:          &sync.Mutex{},
+			sessions:     make(map[string]*Session),
+			sessionsMux:  &sync.Mutex{},
+			socketID:     socket.socketID,
+			stateMux:     &sync.Mutex{},
+			url:          socket.url,
+
+			ctx:    ctx,
+			cancel: cancel,
+			wg:     &sync.WaitGroup{},
+		},
+		id:     sessionID,
+		parent: socket,
+	}
+	session.protocols = newProtocols(session)
+	socket.sessions[sessionID] = session
+
+	socket.logger.Debug("session attached", logger.Fields{"sessionID": sessionID, "socketID": socket.socketID})
+	return session
+}
+
+/*
+handleSessionEvent delivers an event to the session it belongs to.
+*/
+func (socket *Socket) handleSessionEvent(response *Response) {
+	socket.sessionsMux.Lock()
+	session, ok := socket.sessions[response.SessionID]
+	socket.sessionsMux.Unlock()
+
+	if !ok {
+		socket.logger.Debug("event received for unknown session", logger.Fields{"event": response.Method, "sessionID": response.SessionID, "socketID": socket.socketID})
+		return
+	}
+	session.dispatchEvent(response)
+}
+
+/*
+detachSession stops the session referenced by a Target.detachedFromTarget
+event.
+*/
+func (socket *Socket) detachSession(response *Response) {
+	event := &target.DetachedFromTargetEvent{}
+	if err := json.Unmarshal(response.Params, event); nil != err {
+		return
+	}
+	socket.sessionsMux.Lock()
+	session, ok := socket.sessions[string(event.SessionID)]
+	socket.sessionsMux.Unlock()
+	if ok {
+		session.Stop()
+	}
+}
+
+/*
+reattachSessions attaches the sessions of the socket to their targets again
+after the connection was re-established and restores their enabled domains.
+Sessions whose target is unknown or can't be attached are stopped.
+*/
+func (socket *Socket) reattachSessions() {
+	socket.sessionsMux.Lock()
+	sessions := make([]*Session, 0, len(socket.sessions))
+	for _, session := range socket.sessions {
+		sessions = append(sessions, session)
+	}
+	socket.sessionsMux.Unlock()
+
+	for _, session := range sessions {
+		socket.sessionsMux.Lock()
+		oldID := session.id
+		targetID := session.targetID
+		socket.sessionsMux.Unlock()
+		if "" == targetID {
+			socket.logger.Warn("session target unknown, session stopped", logger.Fields{"sessionID": oldID, "socketID": socket.socketID})
+			session.Stop()
+			continue
+		}
+
+		result := <-socket.Target().AttachToTargetContext(socket.ctx, &target.AttachToTargetParams{
+			TargetID: target.TargetID(targetID),
+			Flatten:  true,
+		})
+		if nil != result.Err {
+			socket.logger.Warn("could not re-attach session, session stopped", logger.Fields{"error": result.Err, "sessionID": oldID, "socketID": socket.socketID, "targetID": targetID})
+			session.Stop()
+			continue
+		}
+
+		socket.sessionsMux.Lock()
+		if socket.sessions[oldID] != session {
+			// The session was stopped while attaching.
+			socket.sessionsMux.Unlock()
+			continue
+		}
+		delete(socket.sessions, oldID)
+		session.id = string(result.SessionID)
+		socket.sessions[session.id] = session
+		socket.sessionsMux.Unlock()
+
+		socket.logger.Debug("session re-attached", logger.Fields{"oldSessionID": oldID, "sessionID": result.SessionID, "socketID": socket.socketID, "targetID": targetID})
+		socket.replayDomains(session, session.domains, string(result.SessionID))
+	}
+}
+
+/*
+AttachToTarget attaches to the specified target over the browser connection and
+returns a Session bound to it, see Socket.AttachToTarget.
+*/
+func (session *Session) AttachToTarget(ctx context.Context, targetID string) (*Session, error) {
+	return session.parent.AttachToTarget(ctx, targetID)
+}
+
+/*
+Conn returns the browser websocket connection.
+*/
+func (session *Session) Conn() WebSocketer {
+	return session.parent.Conn()
+}
+
+/*
+Connect establishes the browser websocket connection if it doesn't exist yet.
+
+Connect is a Conner implementation.
+*/
+func (session *Session) Connect() error {
+	return session.parent.Connect()
+}
+
+/*
+Connected returns whether the browser websocket connection exists.
+
+Connected is a Conner implementation.
+*/
+func (session *Session) Connected() bool {
+	return session.parent.Connected()
+}
+
+/*
+CurCommandID returns the latest command ID of the browser connection.
+
+CurCommandID is a Socketer implementation.
+*/
+func (session *Session) CurCommandID() int {
+	return session.parent.CurCommandID()
+}
+
+/*
+Detach detaches the session from its target and stops it.
+*/
+func (session *Session) Detach(ctx context.Context) error {
+	result := <-session.parent.Target().DetachFromTargetContext(ctx, &target.DetachFromTargetParams{
+		SessionID: target.SessionID(session.ID()),
+	})
+	session.Stop()
+	return result.Err
+}
+
+/*
+Disconnect doesn't close the browser websocket connection, which other sessions
+share, and returns a codes.SocketCloseFailed error. Use Detach or Stop to end the
+session.
+
+Disconnect is a Conner implementation.
+*/
+func (session *Session) Disconnect() error {
+	return codes.New(codes.SocketCloseFailed, fmt.Sprintf("session '%s' shares the browser connection, detach or stop the session instead", session.ID()))
+}
+
+/*
+ID returns the session ID. It changes when the session is attached again after
+the browser connection was re-established.
+*/
+func (session *Session) ID() string {
+	session.parent.sessionsMux.Lock()
+	defer session.parent.sessionsMux.Unlock()
+	return session.id
+}
+
+/*
+Listen returns immediately, session messages are read by the browser
+connection.
+
+Listen is a Socketer implementation.
+*/
+func (session *Session) Listen() error {
+	return nil
+}
+
+/*
+OnConnectionState adds a handler that is called each time the state of the
+browser connection changes, see Socket.OnConnectionState.
+*/
+func (session *Session) OnConnectionState(
+	callback func(event *ConnectionStateEvent),
+) {
+	session.parent.OnConnectionState(callback)
+}
+
+/*
+ReadJSON reads the next message of the browser websocket connection. The
+browser connection's read loop normally consumes all messages, so ReadJSON is
+only useful if it isn't listening.
+
+ReadJSON is a Conner implementation.
+*/
+func (session *Session) ReadJSON(v interface{}) error {
+	return session.parent.ReadJSON(v)
+}
+
+/*
+NextCommandID generates and returns the next command ID of the browser
+connection.
+
+NextCommandID is a Socketer implementation.
+*/
+func (session *Session) NextCommandID() int {
+	return session.parent.NextCommandID()
+}
+
+/*
+Pending returns the commands waiting for a response on the browser connection,
+including those sent by other sessions.
+
+Pending is a Socketer implementation.
+*/
+func (session *Session) Pending() []*PendingCommand {
+	return session.parent.Pending()
+}
+
+/*
+SendCommand delivers a command payload for this session to the browser
+connection.
+
+SendCommand is a Socketer implementation.
+*/
+func (session *Session) SendCommand(command Commander) chan *Response {
+	return session.parent.sendCommand(session.parent.ctx, command, session.ID())
+}
+
+/*
+SendCommandContext delivers a command payload for this session to the browser
+connection and abandons the command if the context is done before a response
+is received.
+
+SendCommandContext is a Socketer implementation.
+*/
+func (session *Session) SendCommandContext(
+	ctx context.Context,
+	command Commander,
+) chan *Response {
+	return session.parent.sendCommandContext(ctx, command, session.ID())
+}
+
+/*
+Session returns a Session of the browser connection bound to an attached
+session ID, see Socket.Session.
+*/
+func (session *Session) Session(sessionID string) *Session {
+	return session.parent.Session(sessionID)
+}
+
+/*
+Stop stops delivering events to the session and removes it from the browser
+connection. The target is not detached, see Detach.
+
+Stop is a Socketer implementation.
+*/
+func (session *Session) Stop() {
+	session.parent.sessionsMux.Lock()
+	id := session.id
+	if session.parent.sessions[id] == session {
+		delete(session.parent.sessions, id)
+	}
+	session.parent.sessionsMux.Unlock()
+	session.cancel()
+
+	session.logger.Debug("session stopped", logger.Fields{"sessionID": id, "socketID": session.socketID})
+}
+
+/*
+WriteJSON writes data to the browser websocket connection. A *Payload without a
+session ID is sent to this session.
+
+WriteJSON is a Conner implementation.
+*/
+func (session *Session) WriteJSON(v interface{}) error {
+	if payload, ok := v.(*Payload); ok && "" == payload.SessionID {
+		sessionPayload := *payload
+		sessionPayload.SessionID = session.ID()
+		v = &sessionPayload
+	}
+	return session.parent.WriteJSON(v)
+}
@@ -0,0 +1,412 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+payloadWebSocket is a mock websocket that records the payloads written to it.
+*/
+type payloadWebSocket struct {
+	*MockChromeWebSocket
+	payloads chan *Payload
+}
+
+func (socket *payloadWebSocket) WriteJSON(v interface{}) error {
+	if payload, ok := v.(*Payload); ok {
+		socket.payloads <- payload
+	}
+	return nil
+}
+
+func newSessionMock(name string) (*Socket, *payloadWebSocket) {
+	conn := &payloadWebSocket{
+		MockChromeWebSocket: &MockChromeWebSocket{},
+		payloads:            make(chan *Payload, 10),
+	}
+	socketURL, _ := url.Parse("https://test:9222/" + name)
+	mockSocket := NewMock(socketURL, WithWebSocket(func(socketURL *url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	go func() { _ = mockSocket.Listen() }()
+	return mockSocket, conn
+}
+
+func TestSessionCommand(t *testing.T) {
+	mockSocket, conn := newSessionMock("TestSessionCommand")
+	defer mockSocket.Stop()
+
+	session := mockSocket.Session("session-1")
+	if session != mockSocket.Session("session-1") {
+		t.Errorf("Expected the same session for the same ID")
+	}
+	if "session-1" != session.ID() {
+		t.Errorf("Expected 'session-1', got '%s'", session.ID())
+	}
+
+	resultChan := session.Page().Enable(nil)
+	payload := <-conn.payloads
+	if "session-1" != payload.SessionID {
+		t.Errorf("Expected 'session-1', got '%s'", payload.SessionID)
+	}
+	if payload.ID != mockSocket.CurCommandID() {
+		t.Errorf("Expected command #%d, got #%d", mockSocket.CurCommandID(), payload.ID)
+	}
+	conn.AddMockData(&Response{
+		ID:        payload.ID,
+		Error:     &Error{},
+		Result:    []byte(`{}`),
+		SessionID: "session-1",
+	})
+	if result := <-resultChan; nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	mockSocket.Page().Enable(nil)
+	if payload := <-conn.payloads; "" != payload.SessionID {
+		t.Errorf("Expected no session ID, got '%s'", payload.SessionID)
+	}
+}
+
+func TestSessionEvents(t *testing.T) {
+	mockSocket, conn := newSessionMock("TestSessionEvents")
+	defer mockSocket.Stop()
+
+	session := mockSocket.Session("session-1")
+	sessionEvents, _ := session.Page().SubscribeLoadEventFired(1)
+	socketEvents, _ := mockSocket.Page().SubscribeLoadEventFired(1)
+
+	mockResultBytes, _ := json.Marshal(&page.LoadEventFiredEvent{Timestamp: 1})
+	conn.AddMockData(&Response{
+		Error:     &Error{},
+		Method:    "Page.loadEventFired",
+		Params:    mockResultBytes,
+		SessionID: "session-1",
+	})
+	select {
+	case event := <-sessionEvents:
+		if 1 != event.Timestamp {
+			t.Errorf("Expected 1, got %v", event.Timestamp)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected a session event, got nothing")
+	}
+	select {
+	case <-socketEvents:
+		t.Errorf("Expected the session event not to be delivered to the browser connection")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	detachedBytes, _ := json.Marshal(&target.DetachedFromTargetEvent{SessionID: "session-1"})
+	conn.AddMockData(&Response{
+		Error:  &Error{},
+		Method: "Target.detachedFromTarget",
+		Params: detachedBytes,
+	})
+	select {
+	case <-session.Done():
+	case <-time.After(time.Second):
+		t.Errorf("Expected the session to stop when it is detached")
+	}
+	if session == mockSocket.Session("session-1") {
+		t.Errorf("Expected a new session after the session was detached")
+	}
+}
+
+func TestAttachToTarget(t *testing.T) {
+	mockSocket, conn := newSessionMock("TestAttachToTarget")
+	defer mockSocket.Stop()
+
+	go func() {
+		payload := <-conn.payloads
+		params, _ := json.Marshal(payload.Params)
+		if `{"targetId":"target-1","flatten":true}` != string(params) {
+			t.Errorf("Unexpected params %s", params)
+		}
+		result, _ := json.Marshal(&target.AttachToTargetResult{SessionID: "session-1"})
+		conn.AddMockData(&Response{
+			ID:     payload.ID,
+			Error:  &Error{},
+			Result: result,
+		})
+	}()
+	session, err := mockSocket.AttachToTarget(context.Background(), "target-1")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if "session-1" != session.ID() {
+		t.Errorf("Expected 'session-1', got '%s'", session.ID())
+	}
+
+	go func() {
+		payload := <-conn.payloads
+		conn.AddMockData(&Response{
+			ID: payload.ID,
+			Error: &Error{
+				Code:    -32602,
+				Message: "No target with given id found",
+			},
+		})
+	}()
+	if _, err := mockSocket.AttachToTarget(context.Background(), "target-2"); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+}
+
+func TestSessionConner(t *testing.T) {
+	mockSocket, conn := newSessionMock("TestSessionConner")
+	defer mockSocket.Stop()
+
+	session := mockSocket.Session("session-1")
+	if err := session.Connect(); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if !session.Connected() {
+		t.Errorf("Expected the session to use the browser connection")
+	}
+
+	if err := session.WriteJSON(&Payload{ID: 1, Method: "Page.enable"}); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if payload := <-conn.payloads; "session-1" != payload.SessionID {
+		t.Errorf("Expected 'session-1', got '%s'", payload.SessionID)
+	}
+}
+
+/*
+attachingWebSocket is a droppingWebSocket that answers Target.attachToTarget
+with a new session ID for every connection and records the session ID of each
+command.
+*/
+type attachingWebSocket struct {
+	*droppingWebSocket
+	sessionID string
+	commands  []string
+}
+
+func (socket *attachingWebSocket) WriteJSON(v interface{}) error {
+	payload := v.(*Payload)
+	response := &Response{ID: payload.ID, Result: []byte(`{}`), SessionID: payload.SessionID}
+	if "Target.attachToTarget" == payload.Method {
+		response.Result, _ = json.Marshal(&target.AttachToTargetResult{SessionID: target.SessionID(socket.sessionID)})
+	}
+	socket.mux.Lock()
+	socket.commands = append(socket.commands, payload.SessionID+":"+payload.Method)
+	socket.responses = append(socket.responses, response)
+	socket.mux.Unlock()
+	return nil
+}
+
+func TestSessionReattach(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSessionReattach")
+	mockSocket := NewMock(socketURL, WithReconnect(&ReconnectPolicy{
+		InitialDelay: 10 * time.Millisecond,
+	}))
+
+	connections := make([]*attachingWebSocket, 0)
+	connectionsMux := &sync.Mutex{}
+	mockSocket.newSocket = func(socketURL *url.URL) (WebSocketer, error) {
+		connectionsMux.Lock()
+		defer connectionsMux.Unlock()
+		conn := &attachingWebSocket{
+			droppingWebSocket: &droppingWebSocket{},
+			sessionID:         fmt.Sprintf("session-%d", len(connections)+1),
+		}
+		connections = append(connections, conn)
+		return conn, nil
+	}
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	session, err := mockSocket.AttachToTarget(context.Background(), "target-1")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if result := <-session.Page().Enable(nil); nil != result.Err {
+		t.Fatalf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	unknown := mockSocket.Session("session-unknown")
+
+	connectionsMux.Lock()
+	connections[0].drop()
+	connectionsMux.Unlock()
+
+	// The session is attached to its target again and its enabled Page domain
+	// is restored under the new session ID.
+	expected := []string{":Target.attachToTarget", "session-2:Page.enable"}
+	var commands []string
+	for a := 0; a < 100; a++ {
+		connectionsMux.Lock()
+		count := len(connections)
+		conn := connections[count-1]
+		connectionsMux.Unlock()
+		conn.mux.Lock()
+		commands = append([]string{}, conn.commands...)
+		conn.mux.Unlock()
+		if count > 1 && len(expected) == len(commands) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if fmt.Sprint(expected) != fmt.Sprint(commands) {
+		t.Fatalf("Expected %v, got %v", expected, commands)
+	}
+
+	for a := 0; a < 100 && "session-2" != session.ID(); a++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if "session-2" != session.ID() {
+		t.Errorf("Expected 'session-2', got '%s'", session.ID())
+	}
+	if session != mockSocket.Session("session-2") {
+		t.Errorf("Expected the session to be registered under its new ID")
+	}
+	// The replayed enable command doesn't add a reference.
+	var enabled []*EnabledDomain
+	for a := 0; a < 100; a++ {
+		if enabled = session.EnabledDomains(); 1 == len(enabled) && 1 == enabled[0].Refs {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if 1 != len(enabled) || 1 != enabled[0].Refs {
+		t.Errorf("Expected Page to be enabled once, got %+v", enabled)
+	}
+	select {
+	case <-unknown.Done():
+	case <-time.After(time.Second):
+		t.Errorf("Expected a session without a known target to be stopped")
+	}
+}
+
+func TestSessionMiddleware(t *testing.T) {
+	mockSocket, conn := newSessionMock("TestSessionMiddleware")
+	defer mockSocket.Stop()
+	session := mockSocket.Session("session-1")
+
+	var mux sync.Mutex
+	calls := []string{}
+	record := func(name string) func(next Sender) Sender {
+		return func(next Sender) Sender {
+			return func(ctx context.Context, payload *Payload) *Response {
+				mux.Lock()
+				calls = append(calls, name+":"+payload.SessionID)
+				mux.Unlock()
+				return next(ctx, payload)
+			}
+		}
+	}
+	mockSocket.Use(record("browser"))
+	session.Use(record("session"))
+
+	received := make(chan string, 10)
+	session.UseInbound(func(next Receiver) Receiver {
+		return func(response *Response) {
+			received <- response.Method
+			next(response)
+		}
+	})
+
+	respond := func() {
+		payload := <-conn.payloads
+		conn.AddMockData(&Response{
+			ID:        payload.ID,
+			Error:     &Error{},
+			Result:    []byte(`{}`),
+			SessionID: payload.SessionID,
+		})
+	}
+	go respond()
+	if result := <-session.Page().Enable(nil); nil != result.Err {
+		t.Fatalf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	go respond()
+	if result := <-mockSocket.Page().Enable(nil); nil != result.Err {
+		t.Fatalf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	// The session middleware only sees the session's commands and wraps the
+	// browser connection middleware.
+	mux.Lock()
+	if expected := []string{"session:session-1", "browser:session-1", "browser:"}; fmt.Sprint(expected) != fmt.Sprint(calls) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+	mux.Unlock()
+
+	conn.AddMockData(&Response{Error: &Error{}, Method: "Page.domContentEventFired", Params: []byte(`{}`)})
+	conn.AddMockData(&Response{Error: &Error{}, Method: "Page.loadEventFired", Params: []byte(`{}`), SessionID: "session-1"})
+	select {
+	case method := <-received:
+		if "" != method && "Page.loadEventFired" != method {
+			t.Errorf("Expected only session messages, got '%s'", method)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected the session event to pass through the session middleware")
+	}
+	for {
+		select {
+		case method := <-received:
+			if "" != method && "Page.loadEventFired" != method {
+				t.Errorf("Expected only session messages, got '%s'", method)
+			}
+			continue
+		case <-time.After(50 * time.Millisecond):
+		}
+		break
+	}
+}
+
+func TestSessionDelegation(t *testing.T) {
+	mockSocket, conn := newSessionMock("TestSessionDelegation")
+	defer mockSocket.Stop()
+	session := mockSocket.Session("session-1")
+
+	lost := make(chan struct{}, 1)
+	session.OnConnectionState(func(event *ConnectionStateEvent) {
+		if StateLost == event.State {
+			lost <- struct{}{}
+		}
+	})
+	mockSocket.emitConnectionState(&ConnectionStateEvent{State: StateLost})
+	select {
+	case <-lost:
+	case <-time.After(time.Second):
+		t.Errorf("Expected the browser connection state")
+	}
+
+	if err := session.Connect(); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if err := session.Disconnect(); !codes.Is(err, codes.SocketCloseFailed) {
+		t.Errorf("Expected a SocketCloseFailed error, got '%v'", err)
+	}
+	if !mockSocket.Connected() {
+		t.Errorf("Expected the browser connection to stay open")
+	}
+
+	go func() {
+		payload := <-conn.payloads
+		if "Target.attachToTarget" != payload.Method || "" != payload.SessionID {
+			t.Errorf("Expected Target.attachToTarget on the browser connection, got '%s' for '%s'", payload.Method, payload.SessionID)
+		}
+		result, _ := json.Marshal(&target.AttachToTargetResult{SessionID: "session-2"})
+		conn.AddMockData(&Response{ID: payload.ID, Error: &Error{}, Result: result})
+	}()
+	attached, err := session.AttachToTarget(context.Background(), "target-2")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if attached != mockSocket.Session("session-2") || attached != session.Session("session-2") {
+		t.Errorf("Expected the session to be attached to the browser connection")
+	}
+}
@@ -6,25 +6,31 @@ import (
 	"fmt"
 	"net/url"
 	"sync"
+	"time"
 
-	errs "github.com/bdlm/errors"
-	"github.com/bdlm/log"
+	std "github.com/bdlm/std/error"
 	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/logger"
 )
 
 /*
 New returns a pointer to a websocket struct that implements Socketer interface
-listening to the specified URL.
+listening to the specified URL. Options may be provided to configure the socket.
 */
-func New(url *url.URL) *Socket {
+func New(url *url.URL, options ...Option) *Socket {
 	ctx, cancel := context.WithCancel(context.Background())
 	socket := &Socket{
 		commandIDMux: &sync.Mutex{},
 		commands:     NewCommandMap(),
+		domains:      newDomainTracker(),
 		handlers:     NewEventHandlerMap(),
 		mux:          &sync.Mutex{},
+		middleware:   newMiddleware(),
 		newSocket:    NewWebsocket,
+		sessions:     make(map[string]*Session),
+		sessionsMux:  &sync.Mutex{},
 		socketID:     NextSocketID(),
+		stateMux:     &sync.Mutex{},
 		url:          url,
 
 		ctx:    ctx,
@@ -32,55 +38,30 @@ func New(url *url.URL) *Socket {
 		wg:     &sync.WaitGroup{},
 	}
 
+	for _, option := range options {
+		option(socket)
+	}
+	if nil == socket.logger {
+		socket.logger = logger.Nop()
+	} else if !socket.logPayloads {
+		socket.logger = logger.Redact(socket.logger, logger.PayloadFields...)
+	}
+
 	// Init the protocol interfaces for the API.
-	socket.accessibility = &AccessibilityProtocol{Socket: socket}
-	socket.animation = &AnimationProtocol{Socket: socket}
-	socket.applicationCache = &ApplicationCacheProtocol{Socket: socket}
-	socket.audits = &AuditsProtocol{Socket: socket}
-	socket.browser = &BrowserProtocol{Socket: socket}
-	socket.cacheStorage = &CacheStorageProtocol{Socket: socket}
-	socket.console = &ConsoleProtocol{Socket: socket}
-	socket.css = &CSSProtocol{Socket: socket}
-	socket.database = &DatabaseProtocol{Socket: socket}
-	socket.debugger = &DebuggerProtocol{Socket: socket}
-	socket.deviceOrientation = &DeviceOrientationProtocol{Socket: socket}
-	socket.domDebugger = &DOMDebuggerProtocol{Socket: socket}
-	socket.domSnapshot = &DOMSnapshotProtocol{Socket: socket}
-	socket.domStorage = &DOMStorageProtocol{Socket: socket}
-	socket.dom = &DOMProtocol{Socket: socket}
-	socket.emulation = &EmulationProtocol{Socket: socket}
-	socket.headlessExperimental = &HeadlessExperimentalProtocol{Socket: socket}
-	socket.heapProfiler = &HeapProfilerProtocol{Socket: socket}
-	socket.indexedDB = &IndexedDBProtocol{Socket: socket}
-	socket.input = &InputProtocol{Socket: socket}
-	socket.io = &IOProtocol{Socket: socket}
-	socket.layerTree = &LayerTreeProtocol{Socket: socket}
-	socket.log = &LogProtocol{Socket: socket}
-	socket.memory = &MemoryProtocol{Socket: socket}
-	socket.network = &NetworkProtocol{Socket: socket}
-	socket.overlay = &OverlayProtocol{Socket: socket}
-	socket.page = &PageProtocol{Socket: socket}
-	socket.performance = &PerformanceProtocol{Socket: socket}
-	socket.profiler = &ProfilerProtocol{Socket: socket}
-	socket.runtime = &RuntimeProtocol{Socket: socket}
-	socket.schema = &SchemaProtocol{Socket: socket}
-	socket.security = &SecurityProtocol{Socket: socket}
-	socket.serviceWorker = &ServiceWorkerProtocol{Socket: socket}
-	socket.storage = &StorageProtocol{Socket: socket}
-	socket.systemInfo = &SystemInfoProtocol{Socket: socket}
-	socket.target = &TargetProtocol{Socket: socket}
-	socket.tethering = &TetheringProtocol{Socket: socket}
-	socket.tracing = &TracingProtocol{Socket: socket}
+	socket.protocols = newProtocols(socket)
 
+	// Stop waits for the listener, add it before it starts so Stop can't
+	// return early.
+	socket.wg.Add(1)
 	go func() {
+		defer socket.wg.Done()
 		err := socket.Listen()
 		if nil != err {
-			log.WithError(err).Error("could not start socket listener")
+			socket.logger.Error("could not start socket listener", logger.Fields{"error": err, "socketID": socket.socketID})
 		}
 	}()
 
-	log.WithFields(log.Fields{"socketID": socket.socketID, "url": socket.url.String()}).
-		Info("New socket connection listening")
+	socket.logger.Info("New socket connection listening", logger.Fields{"socketID": socket.socketID, "url": socket.url.String()})
 
 	return socket
 }
@@ -104,60 +85,38 @@ func NextSocketID() int {
 Socket is a Socketer implementation.
 */
 type Socket struct {
-	commandID    int
-	commandIDMux *sync.Mutex
-	commands     CommandMapper
-	conn         WebSocketer
-	connected    bool
-	handlers     EventHandlerMapper
-	mux          *sync.Mutex
-	newSocket    func(socketURL *url.URL) (WebSocketer, error)
-	socketID     int
-	url          *url.URL
+	commandID      int
+	commandIDMux   *sync.Mutex
+	commandTimeout time.Duration
+	commands       CommandMapper
+	compatibility  *compatibilityCheck
+	conn           WebSocketer
+	connected      bool
+	dialed         bool
+	domains        *domainTracker
+	events         *EventQueue
+	handlers       EventHandlerMapper
+	logPayloads    bool
+	logger         logger.Logger
+	middleware     *middleware
+	mux            *sync.Mutex
+	newSocket      func(socketURL *url.URL) (WebSocketer, error)
+	reaper         sync.Once
+	sessions       map[string]*Session
+	sessionsMux    *sync.Mutex
+	socketID       int
+	url            *url.URL
+
+	reconnectPolicy *ReconnectPolicy
+	stateHandlers   []func(event *ConnectionStateEvent)
+	stateMux        *sync.Mutex
 
 	ctx    context.Context
 	cancel context.CancelFunc
 	wg     *sync.WaitGroup
 
 	// Protocol interfaces for the API.
-	accessibility        *AccessibilityProtocol
-	animation            *AnimationProtocol
-	applicationCache     *ApplicationCacheProtocol
-	audits               *AuditsProtocol
-	browser              *BrowserProtocol
-	cacheStorage         *CacheStorageProtocol
-	console              *ConsoleProtocol
-	css                  *CSSProtocol
-	database             *DatabaseProtocol
-	debugger             *DebuggerProtocol
-	deviceOrientation    *DeviceOrientationProtocol
-	domDebugger          *DOMDebuggerProtocol
-	domSnapshot          *DOMSnapshotProtocol
-	domStorage           *DOMStorageProtocol
-	dom                  *DOMProtocol
-	emulation            *EmulationProtocol
-	headlessExperimental *HeadlessExperimentalProtocol
-	heapProfiler         *HeapProfilerProtocol
-	indexedDB            *IndexedDBProtocol
-	input                *InputProtocol
-	io                   *IOProtocol
-	layerTree            *LayerTreeProtocol
-	log                  *LogProtocol
-	memory               *MemoryProtocol
-	network              *NetworkProtocol
-	overlay              *OverlayProtocol
-	page                 *PageProtocol
-	performance          *PerformanceProtocol
-	profiler             *ProfilerProtocol
-	runtime              *RuntimeProtocol
-	schema               *SchemaProtocol
-	security             *SecurityProtocol
-	serviceWorker        *ServiceWorkerProtocol
-	storage              *StorageProtocol
-	systemInfo           *SystemInfoProtocol
-	target               *TargetProtocol
-	tethering            *TetheringProtocol
-	tracing              *TracingProtocol
+	protocols
 }
 
 /*
@@ -183,24 +142,32 @@ func (socket *Socket) CurCommandID() int {
 	return id
 }
 
+/*
+Done returns a channel that is closed when the socket is stopped.
+
+Done is a Socketer implementation.
+*/
+func (socket *Socket) Done() <-chan struct{} {
+	return socket.ctx.Done()
+}
+
 /*
 handleResponse receives the responses to requests sent to the websocket
 connection.
 */
 func (socket *Socket) handleResponse(response *Response) {
 	// Log a message on error
-	if command, err := socket.commands.Get(response.ID); nil != err {
-		err = errs.Wrap(err, codes.SocketCmdHandlerNotFound, fmt.Sprintf("command #%d not found", response.ID))
-		log.WithFields(log.Fields{"error": err, "result": response.Result, "socketID": socket.socketID}).
-			Debug(response.Error)
+	if command, err := socket.commands.Pop(response.ID); nil != err {
+		err = codes.Wrap(err, codes.SocketCmdHandlerNotFound, fmt.Sprintf("command #%d not found", response.ID))
+		socket.logger.Debug("command not found", logger.Fields{"error": err, "result": response.Result, "socketID": socket.socketID})
 
 	} else {
-		log.WithFields(log.Fields{"commandID": command.ID(), "method": command.Method(), "socketID": socket.socketID}).
-			Debug("executing handler")
+		socket.logger.Debug("executing handler", logger.Fields{"commandID": command.ID(), "method": command.Method(), "socketID": socket.socketID})
+		if tracker := socket.domainsFor(response.SessionID); nil != tracker {
+			tracker.track(command, response)
+		}
 		command.Respond(response)
-		socket.commands.Delete(command.ID())
-		log.WithFields(log.Fields{"commandID": command.ID(), "method": command.Method(), "socketID": socket.socketID, "url": socket.url.String()}).
-			Debug("Command complete")
+		socket.logger.Debug("Command complete", logger.Fields{"commandID": command.ID(), "method": command.Method(), "socketID": socket.socketID, "url": socket.url.String()})
 	}
 }
 
@@ -211,58 +178,98 @@ connection.
 func (socket *Socket) handleEvent(
 	response *Response,
 ) {
-	log.WithFields(log.Fields{"event": response.Method, "socketID": socket.socketID, "url": socket.url.String()}).
-		Debug("handling event")
+	socket.logger.Debug("handling event", logger.Fields{"event": response.Method, "socketID": socket.socketID, "url": socket.url.String()})
 
 	if response.Method == "Inspector.targetCrashed" {
-		log.WithFields(log.Fields{"socketID": socket.socketID}).
-			Error("Chrome has crashed!")
+		socket.logger.Error("Chrome has crashed!", logger.Fields{"socketID": socket.socketID})
+	}
+
+	if "" != response.SessionID {
+		socket.handleSessionEvent(response)
+		return
+	}
+	if response.Method == "Target.detachedFromTarget" {
+		socket.detachSession(response)
+	}
+	socket.dispatchEvent(response)
+}
+
+/*
+dispatchEvent delivers an event to the handlers registered for it and for the
+patterns matching it.
+*/
+func (socket *Socket) dispatchEvent(
+	response *Response,
+) {
+	handlers := socket.handlers.Match(response.Method)
+	if 0 == len(handlers) {
+		socket.logger.Debug("no event handlers found", logger.Fields{"event": response.Method, "socketID": socket.socketID})
 	}
+	for a, handler := range handlers {
+		socket.logger.Debug("Executing handler", logger.Fields{"event": response.Method, "handler#": a, "socketID": socket.socketID})
+		socket.dispatch(handler, response)
+	}
+}
 
-	if handlers, err := socket.handlers.Get(response.Method); nil != err {
-		log.WithFields(log.Fields{"error": err, "socketID": socket.socketID}).
-			Debug(err)
+/*
+dispatch delivers an event to a handler. Handlers with their own queue and
+sockets in ordered mode queue the event, otherwise the handler is executed in a
+new goroutine.
+*/
+func (socket *Socket) dispatch(handler EventHandler, response *Response) {
+	if queued, ok := handler.(queuedHandler); ok && nil != queued.Queue() {
+		queued.Queue().post(func() { handler.Handle(response) })
+	} else if nil != socket.events {
+		socket.events.post(func() { handler.Handle(response) })
 	} else {
-		for a, event := range handlers {
-			log.WithFields(log.Fields{"event": response.Method, "handler#": a, "socketID": socket.socketID}).
-				Info("Executing handler")
-			go event.Handle(response)
-		}
+		go handler.Handle(response)
 	}
 }
 
+/*
+DroppedEvents returns the number of events discarded by the ordered event queue
+because it was full. It is always 0 unless WithOrderedEvents is used.
+*/
+func (socket *Socket) DroppedEvents() uint64 {
+	if nil == socket.events {
+		return 0
+	}
+	return socket.events.Dropped()
+}
+
 /*
 handleUnknown receives all other socket responses.
 */
 func (socket *Socket) handleUnknown(
 	response *Response,
 ) {
-	log.WithFields(log.Fields{"socketID": socket.socketID, "url": socket.url.String()}).
-		Debug("handling unexpected data")
+	socket.logger.Debug("handling unexpected data", logger.Fields{"socketID": socket.socketID, "url": socket.url.String()})
 	var command Commander
 	var err error
 
 	// Check for a command listening for ID 0
 	if command, err = socket.commands.Get(response.ID); nil != err {
-		err = errs.Wrap(err, codes.SocketCmdHandlerNotFound, fmt.Sprintf("command #%d not found", response.ID))
+		err = codes.Wrap(err, codes.SocketCmdHandlerNotFound, fmt.Sprintf("command #%d not found", response.ID))
 		if nil != response.Error && 0 != response.Error.Code {
-			e := err.(*errs.Err)
+			e := err.(*codes.Err)
 			err = e.With(response.Error, err.Error())
 		}
-		log.WithFields(log.Fields{"error": err, "result": response.Result, "socketID": socket.socketID}).
-			Debug(err)
+		socket.logger.Debug(err.Error(), logger.Fields{"error": err, "result": response.Result, "socketID": socket.socketID})
 		return
 	}
 
 	command.Respond(response)
-	log.WithFields(log.Fields{"commandID": command.ID(), "error": response.Error, "method": command.Method(), "socketID": socket.socketID}).
-		Debug("Unrecognised socket message")
+	socket.logger.Debug("Unrecognised socket message", logger.Fields{"commandID": command.ID(), "error": response.Error, "method": command.Method(), "socketID": socket.socketID})
 }
 
 /*
 Listen starts the socket read loop and delivers messages to handleResponse() and
 handleEvent() as appropriate.
 
+If a reconnect policy has been configured and the connection is lost, Listen
+fails all in-flight commands, re-dials the websocket and restores the enabled
+protocol domains before resuming the read loop.
+
 Listen is a Socketer implementation.
 */
 func (socket *Socket) Listen() error {
@@ -272,82 +279,137 @@ func (socket *Socket) Listen() error {
 	// also returns the error signal.
 	defer func() {
 		if r := recover(); r != nil {
-			err := errs.New(codes.SocketPanic, "recovered from panic in Socket.listen()")
+			err := codes.New(codes.SocketPanic, "recovered from panic in Socket.listen()")
 			if e, ok := r.(error); ok {
-				err = errs.Wrap(e, codes.SocketPanic, "recovered from panic in Socket.listen()")
+				err = codes.Wrap(e, codes.SocketPanic, "recovered from panic in Socket.listen()")
 			}
-			log.WithFields(log.Fields{"error": err}).
-				Error(err)
+			socket.logger.Error(err.Error(), logger.Fields{"error": err})
 		}
 	}()
 
+	socket.emitConnectionState(&ConnectionStateEvent{State: StateConnecting})
 	err = socket.Connect()
 	if nil != err {
-		return errs.Wrap(err, 0, "socket connection failed")
+		socket.emitConnectionState(&ConnectionStateEvent{State: StateGaveUp, Err: err})
+		return codes.Wrap(err, 0, "socket connection failed")
 	}
+	socket.emitConnectionState(&ConnectionStateEvent{State: StateConnected})
 	defer socket.Disconnect()
 
-	socket.wg.Add(1)
-	defer socket.wg.Done()
+	if nil != socket.compatibility {
+		go socket.checkCompatibility()
+	}
+
+	if socket.commandTimeout > 0 {
+		socket.reaper.Do(func() {
+			socket.wg.Add(1)
+			go socket.reap()
+		})
+	}
 
-	readCh := make(chan *Response) // websocket data
-	errCh := make(chan error)      // websocket errors
 	for {
+		err = socket.listen()
+		if nil == err || nil == socket.reconnectPolicy || nil != socket.ctx.Err() {
+			return err
+		}
 
-		// Fetch the next socket response in the background.
-		go func() {
-			response := &Response{}
-			err := socket.ReadJSON(&response)
-			if nil != err {
-				errCh <- err
-			} else {
-				readCh <- response
-			}
-		}()
+		socket.connectionLost(err)
+		if err = socket.reconnect(); nil != err {
+			return err
+		}
+	}
+}
 
+/*
+listen runs the read loop for the current websocket connection. Messages are
+read by a single goroutine for the lifetime of the connection and handled in
+order on this goroutine. It returns nil when the socket is stopped or the read
+error that ended the loop.
+*/
+func (socket *Socket) listen() error {
+	socket.mux.Lock()
+	conn := socket.conn
+	socket.mux.Unlock()
+	if nil == conn {
+		return codes.New(codes.SocketNotConnected, "not connected")
+	}
+
+	readCh := make(chan *Response, readQueueSize) // websocket data
+	errCh := make(chan error, 1)                  // websocket errors
+	done := make(chan struct{})
+	defer close(done)
+	go socket.read(conn, readCh, errCh, done)
+
+	for {
 		select {
 		// Shutdown when signaled.
 		case <-socket.ctx.Done():
-			log.WithField("socketID", socket.socketID).Debug("shutting down socket listener")
+			socket.logger.Debug("shutting down socket listener", logger.Fields{"socketID": socket.socketID})
 			return nil
 
-		// Process any errors
+		// Process any errors once the messages read before the error
+		// have been handled.
 		case err := <-errCh:
-			return err
+			for {
+				select {
+				case response := <-readCh:
+					socket.middleware.receiver(socket.receive)(response)
+				default:
+					return err
+				}
+			}
 
 		// Process the next socket response.
 		case response := <-readCh:
-			if 0 == response.ID &&
-				"" == response.Method &&
-				0 == len(response.Params) &&
-				0 == len(response.Result) {
-				log.WithFields(log.Fields{"socketID": socket.socketID}).
-					Debug("nil response from socket")
-			}
+			socket.middleware.receiver(socket.receive)(response)
+		}
+	}
+}
 
-			if response.ID > 0 {
-				log.WithFields(log.Fields{"responseID": response.ID, "socketID": socket.socketID}).
-					Debug("sending to command handler")
-				socket.handleResponse(response)
-
-			} else if "" != response.Method {
-				log.WithFields(log.Fields{"method": response.Method, "socketID": socket.socketID}).
-					Debug("sending to event handler")
-				socket.handleEvent(response)
-
-			} else {
-				tmp, _ := json.Marshal(response)
-				log.WithFields(log.Fields{"data": string(tmp), "method": response.Method, "responseID": response.ID, "socketID": socket.socketID}).
-					Error("Unknown response from web socket")
-
-				if nil == response.Error {
-					response.Error = &Error{
-						Message: "Unknown response from web socket",
-					}
-				}
-				socket.handleUnknown(response)
+/*
+receive delivers a message read from the websocket connection through the
+inbound middleware of the session it belongs to, if any, see route.
+*/
+func (socket *Socket) receive(response *Response) {
+	if "" != response.SessionID {
+		if chain := socket.middlewareFor(response.SessionID); chain != socket.middleware && chain.hasInbound() {
+			chain.receiver(socket.route)(response)
+			return
+		}
+	}
+	socket.route(response)
+}
+
+/*
+route delivers a message to the command handler, the event handlers or the
+unknown message handler.
+*/
+func (socket *Socket) route(response *Response) {
+	if 0 == response.ID &&
+		"" == response.Method &&
+		0 == len(response.Params) &&
+		0 == len(response.Result) {
+		socket.logger.Debug("nil response from socket", logger.Fields{"socketID": socket.socketID})
+	}
+
+	if response.ID > 0 {
+		socket.logger.Debug("sending to command handler", logger.Fields{"responseID": response.ID, "socketID": socket.socketID})
+		socket.handleResponse(response)
+
+	} else if "" != response.Method {
+		socket.logger.Debug("sending to event handler", logger.Fields{"method": response.Method, "socketID": socket.socketID})
+		socket.handleEvent(response)
+
+	} else {
+		tmp, _ := json.Marshal(response)
+		socket.logger.Error("Unknown response from web socket", logger.Fields{"data": string(tmp), "method": response.Method, "responseID": response.ID, "socketID": socket.socketID})
+
+		if nil == response.Error {
+			response.Error = &Error{
+				Message: "Unknown response from web socket",
 			}
 		}
+		socket.handleUnknown(response)
 	}
 }
 
@@ -364,6 +426,16 @@ func (socket *Socket) NextCommandID() int {
 	return id
 }
 
+/*
+Pending returns the commands that are waiting for a response, ordered by command
+ID.
+
+Pending is a Socketer implementation.
+*/
+func (socket *Socket) Pending() []*PendingCommand {
+	return socket.commands.Pending()
+}
+
 /*
 RemoveEventHandler removes a handler from the stack of listeners for an event.
 
@@ -377,23 +449,20 @@ func (socket *Socket) RemoveEventHandler(
 
 	handlers, err := socket.handlers.Get(handler.Name())
 	if nil != err {
-		log.WithFields(log.Fields{"error": err, "socketID": socket.socketID}).
-			Warn("Could not remove handler")
-		return errs.Wrap(err, 0, fmt.Sprintf("failed to remove event handler '%s'", handler.Name()))
+		socket.logger.Warn("Could not remove handler", logger.Fields{"error": err, "socketID": socket.socketID})
+		return codes.Wrap(err, 0, fmt.Sprintf("failed to remove event handler '%s'", handler.Name()))
 	}
 
 	for i, hndlr := range handlers {
 		if hndlr == handler {
 			handlers = append(handlers[:i], handlers[i+1:]...)
 			socket.handlers.Set(handler.Name(), handlers)
-			log.WithFields(log.Fields{"handler": handler.Name(), "handlerID": i, "socketID": socket.socketID}).
-				Info("Removed event handler")
+			socket.logger.Debug("Removed event handler", logger.Fields{"handler": handler.Name(), "handlerID": i, "socketID": socket.socketID})
 			return nil
 		}
 	}
 
-	log.WithFields(log.Fields{"socketID": socket.socketID}).
-		Warn("handler not found")
+	socket.logger.Warn("handler not found", logger.Fields{"socketID": socket.socketID})
 	return nil
 }
 
@@ -403,40 +472,159 @@ SendCommand delivers a command payload to the websocket connection.
 SendCommand is a Socketer implementation.
 
 Workflow:
-	1. The socket's command mutex is locked.
-	2. The command counter is incremented.
-	3. The payload is sent to the socket connection and the mutex is unlocked.
-	4. The command is stored using the generated ID.
-	5. When the command has been executed and the socket responds,
-	socket.HandleCmd() is triggered from the command instance to generate the
-	response and the command unlocks itself.
+ 1. The socket's command mutex is locked.
+ 2. The command counter is incremented.
+ 3. The command is stored using the generated ID.
+ 4. The payload is sent to the socket connection and the mutex is unlocked.
+ 5. When the command has been executed and the socket responds,
+    socket.HandleCmd() is triggered from the command instance to generate the
+    response and the command unlocks itself.
 */
 func (socket *Socket) SendCommand(command Commander) chan *Response {
-	log.WithFields(log.Fields{"commandID": command.ID(), "method": command.Method(), "socketID": socket.socketID}).
-		Debug("sending command payload to socket")
+	return socket.sendCommand(socket.ctx, command, "")
+}
+
+/*
+sendCommand delivers a command payload to the websocket connection. A non-empty
+sessionID routes the command to an attached target session. Commands sent
+through outbound middleware are abandoned when ctx is done.
+*/
+func (socket *Socket) sendCommand(
+	ctx context.Context,
+	command Commander,
+	sessionID string,
+) chan *Response {
+	socket.logger.Debug("sending command payload to socket", logger.Fields{"commandID": command.ID(), "method": command.Method(), "sessionID": sessionID, "socketID": socket.socketID})
+
+	if socket.holdCommand(ctx, command, sessionID) {
+		return command.Response()
+	}
+	if socket.holdDisable(command, sessionID) {
+		return command.Response()
+	}
+
+	if chain := socket.middlewareFor(sessionID); chain.hasOutbound() {
+		go socket.sendThrough(ctx, chain, command, &Payload{
+			ID:        command.ID(),
+			Method:    command.Method(),
+			Params:    command.Params(),
+			SessionID: sessionID,
+		})
+		return command.Response()
+	}
+
+	// Store the command before returning and before writing the payload so a
+	// fast response can't arrive before the command is available to handle
+	// it.
+	socket.commands.Set(command)
 	go func() {
 		payload := &Payload{
-			ID:     command.ID(),
-			Method: command.Method(),
-			Params: command.Params(),
+			ID:        command.ID(),
+			Method:    command.Method(),
+			Params:    command.Params(),
+			SessionID: sessionID,
 		}
-
 		if err := socket.WriteJSON(payload); err != nil {
-			err = errs.Wrap(err, 0, "write failed: could not write data to websocket")
-			command.Respond(&Response{Error: &Error{
-				Code:    1,
-				Data:    []byte(fmt.Sprintf(`"%#v"`, err)),
-				Message: "Failed to send command payload to socket connection",
-			}})
+			if _, popErr := socket.commands.Pop(command.ID()); nil != popErr {
+				// The command has already been failed by the reaper.
+				return
+			}
+			err = codes.Wrap(err, codes.SocketWriteFailed, "write failed: could not write data to websocket")
+			socket.logger.Debug("command write failed", logger.Fields{"commandID": command.ID(), "error": err, "socketID": socket.socketID})
+			socket.failCommand(command, codes.SocketWriteFailed, err)
 			return
 		}
-
-		socket.commands.Set(command)
 	}()
 
 	return command.Response()
 }
 
+/*
+SendCommandContext delivers a command payload to the websocket connection. If
+the context is done before a response is received the command is removed from
+the command stack, the command error is set to a codes.SocketCommandCancelled
+error and an error response is returned.
+
+SendCommandContext is a Socketer implementation.
+*/
+func (socket *Socket) SendCommandContext(
+	ctx context.Context,
+	command Commander,
+) chan *Response {
+	return socket.sendCommandContext(ctx, command, "")
+}
+
+/*
+sendCommandContext delivers a command payload to the websocket connection and
+abandons the command if the context is done before a response is received. A
+non-empty sessionID routes the command to an attached target session.
+*/
+func (socket *Socket) sendCommandContext(
+	ctx context.Context,
+	command Commander,
+	sessionID string,
+) chan *Response {
+	responseChan := make(chan *Response, 1)
+
+	if nil != ctx.Err() {
+		socket.cancelCommand(ctx, command, responseChan)
+		return responseChan
+	}
+
+	commandResponse := socket.sendCommand(ctx, command, sessionID)
+	go func() {
+		select {
+		case response := <-commandResponse:
+			responseChan <- response
+		case <-ctx.Done():
+			socket.cancelCommand(ctx, command, responseChan)
+		}
+	}()
+
+	return responseChan
+}
+
+/*
+failCommand delivers an error response to a command that will never receive a
+response from Chromium. The command error is set to err.
+*/
+func (socket *Socket) failCommand(
+	command Commander,
+	code std.Code,
+	err error,
+) {
+	command.SetError(err)
+	command.Respond(&Response{
+		Error: &Error{
+			Code:    int(code),
+			Message: err.Error(),
+		},
+		ID: command.ID(),
+	})
+}
+
+/*
+cancelCommand discards a pending command whose context is done and delivers a
+cancellation response.
+*/
+func (socket *Socket) cancelCommand(
+	ctx context.Context,
+	command Commander,
+	responseChan chan *Response,
+) {
+	socket.commands.Delete(command.ID())
+	err := codes.Wrap(ctx.Err(), codes.SocketCommandCancelled, fmt.Sprintf("command #%d '%s' cancelled", command.ID(), command.Method()))
+	command.SetError(err)
+	socket.logger.Debug("command cancelled", logger.Fields{"commandID": command.ID(), "error": err, "method": command.Method(), "socketID": socket.socketID})
+	responseChan <- &Response{
+		Error: &Error{
+			Code:    int(codes.SocketCommandCancelled),
+			Message: err.Error(),
+		},
+		ID: command.ID(),
+	}
+}
+
 /*
 Stop signals the socket read loop to stop listening for data and close the
 websocket connection.
@@ -444,9 +632,12 @@ websocket connection.
 Stop is a Socketer implementation.
 */
 func (socket *Socket) Stop() {
-	log.WithFields(log.Fields{"socketID": socket.socketID}).Info("closing websocket")
+	socket.logger.Info("closing websocket", logger.Fields{"socketID": socket.socketID})
 	socket.cancel()
 	socket.wg.Wait()
+	if nil != socket.events {
+		socket.events.Close()
+	}
 }
 
 /*
@@ -1,10 +1,15 @@
 package socket
 
 import (
+	"context"
+	"errors"
 	"net/url"
 	"reflect"
 	"testing"
 	"time"
+
+	std "github.com/bdlm/std/error"
+	"github.com/mkenney/go-chrome/codes"
 )
 
 func TestNewSocket(t *testing.T) {
@@ -130,6 +135,138 @@ func TestListenCommandUnknown(t *testing.T) {
 	}
 }
 
+func TestHandleUnknownProtocolError(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestHandleUnknownProtocolError")
+	capture := &captureLogger{}
+	mockSocket := NewMock(socketURL, WithLogger(capture), WithPayloadLogging())
+	defer mockSocket.Stop()
+
+	responseError := &Error{Code: -32000, Message: "Internal error"}
+	mockSocket.handleUnknown(&Response{ID: 99, Error: responseError})
+
+	capture.mux.Lock()
+	defer capture.mux.Unlock()
+	for _, fields := range capture.fields {
+		err, ok := fields["error"].(error)
+		if !ok {
+			continue
+		}
+		var protocolErr *Error
+		if !errors.As(err, &protocolErr) || protocolErr != responseError {
+			t.Errorf("Expected the response error in the error chain, got '%v'", err)
+		}
+		if !codes.Is(err, codes.SocketCmdHandlerNotFound) {
+			t.Errorf("Expected code %d, got '%v'", codes.SocketCmdHandlerNotFound, err)
+		}
+		return
+	}
+	t.Errorf("Expected the error to be logged")
+}
+
+func TestSendCommandContext(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSendCommandContext")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	command := NewCommand(mockSocket, "Some.method", nil)
+	resultChan := mockSocket.SendCommandContext(context.Background(), command)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Method: "Some.method",
+		Result: []byte(`"Mock Command Result"`),
+	})
+	result := <-resultChan
+	if `"Mock Command Result"` != string(result.Result) {
+		t.Errorf("Invalid result: expected 'Mock Command Result', received '%s'", result.Result)
+	}
+	if nil != command.Error() {
+		t.Errorf("Expected nil, received error: %s", command.Error().Error())
+	}
+}
+
+func TestSendCommandWriteFailed(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSendCommandWriteFailed")
+	mockSocket := NewMock(socketURL)
+	if err := mockSocket.Connect(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+	if err := mockSocket.Disconnect(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	command := NewCommand(mockSocket, "Some.method", nil)
+	result := <-mockSocket.SendCommand(command)
+	if nil == result.Error || int(codes.SocketWriteFailed) != result.Error.Code {
+		t.Errorf("Expected a codes.SocketWriteFailed response error, got %#v", result.Error)
+	}
+	if !codes.Is(command.Error(), codes.SocketWriteFailed) {
+		t.Errorf("Expected a codes.SocketWriteFailed error, got '%v'", command.Error())
+	}
+}
+
+func TestSendCommandContextCancel(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSendCommandContextCancel")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	command := NewCommand(mockSocket, "Some.method", nil)
+	result := <-mockSocket.SendCommandContext(ctx, command)
+	if nil == result.Error {
+		t.Errorf("Expected error, received nil")
+	}
+	if nil == command.Error() {
+		t.Errorf("Expected command error, received nil")
+	}
+	if _, err := mockSocket.commands.Get(command.ID()); nil == err {
+		t.Errorf("Expected cancelled command to be removed from the command map")
+	}
+
+	// A late response must not block the read loop.
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Result: []byte(`"Mock Command Result"`),
+	})
+	command = NewCommand(mockSocket, "Some.method", nil)
+	resultChan := mockSocket.SendCommand(command)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Result: []byte(`"Mock Command Result"`),
+	})
+	select {
+	case <-resultChan:
+	case <-time.After(5 * time.Second):
+		t.Errorf("Timed out waiting for command response")
+	}
+}
+
+func TestSendCommandTimeout(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSendCommandTimeout")
+	mockSocket := NewMock(socketURL, WithCommandTimeout(100*time.Millisecond))
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	resultChan := mockSocket.Page().Enable(nil)
+	select {
+	case result := <-resultChan:
+		err, ok := result.Err.(interface{ Code() std.Code })
+		if !ok || codes.SocketCommandTimeout != err.Code() {
+			t.Errorf("Expected a codes.SocketCommandTimeout error, got '%v'", result.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for the command to expire")
+	}
+	if pending := mockSocket.Pending(); 0 != len(pending) {
+		t.Errorf("Expected no pending commands, got %d", len(pending))
+	}
+}
+
 func TestRemoveEventHandler(t *testing.T) {
 	var err error
 	socketURL, _ := url.Parse("https://test:9222/TestRemoveEventHandler")
@@ -0,0 +1,147 @@
+package socket
+
+import (
+	"sync"
+)
+
+/*
+Subscribe adds an event handler to the socket and returns a Subscription that
+can be used to remove it again.
+*/
+func Subscribe(socket Socketer, handler EventHandler) *Subscription {
+	socket.AddEventHandler(handler)
+	return &Subscription{
+		handler: handler,
+		once:    &sync.Once{},
+		socket:  socket,
+	}
+}
+
+/*
+Subscription is a handle for an event handler added to a socket.
+*/
+type Subscription struct {
+	handler EventHandler
+	once    *sync.Once
+	socket  Socketer
+	stream  *EventStream
+}
+
+/*
+Dropped returns the number of events discarded by the subscription's ordered
+queue because it was full. It is always 0 for unordered subscriptions.
+*/
+func (subscription *Subscription) Dropped() uint64 {
+	if queued, ok := subscription.handler.(queuedHandler); ok && nil != queued.Queue() {
+		return queued.Queue().Dropped()
+	}
+	return 0
+}
+
+/*
+Event returns the name of the subscribed event.
+*/
+func (subscription *Subscription) Event() string {
+	return subscription.handler.Name()
+}
+
+/*
+Ordered delivers the subscription's events through a serial queue, in the order
+they were read from the websocket. The queue buffers up to size events and
+handles overflow according to policy. Ordered has no effect on handlers that
+are not created by NewEventHandler.
+*/
+func (subscription *Subscription) Ordered(size int, policy OverflowPolicy) *Subscription {
+	if queued, ok := subscription.handler.(queuedHandler); ok {
+		if queue := queued.Queue(); nil != queue {
+			queue.Close()
+		}
+		queued.SetQueue(NewEventQueue(size, policy))
+	}
+	return subscription
+}
+
+/*
+Unsubscribe removes the event handler from the socket. If the subscription
+delivers events to a channel, the channel is closed. Calling Unsubscribe more
+than once has no effect.
+*/
+func (subscription *Subscription) Unsubscribe() error {
+	var err error
+	subscription.once.Do(func() {
+		err = subscription.socket.RemoveEventHandler(subscription.handler)
+		if queued, ok := subscription.handler.(queuedHandler); ok && nil != queued.Queue() {
+			queued.Queue().Close()
+		}
+		if nil != subscription.stream {
+			subscription.stream.close()
+		}
+	})
+	return err
+}
+
+/*
+NewEventStream returns an EventStream for a typed subscription channel.
+closeChan closes the channel and is called exactly once.
+*/
+func NewEventStream(socket Socketer, closeChan func()) *EventStream {
+	return &EventStream{
+		closeChan: closeChan,
+		done:      make(chan struct{}),
+		mux:       &sync.RWMutex{},
+		socket:    socket,
+	}
+}
+
+/*
+EventStream guards the delivery of events to a typed subscription channel so
+the channel is never written to after it has been closed.
+*/
+type EventStream struct {
+	closeChan func()
+	closed    bool
+	done      chan struct{}
+	mux       *sync.RWMutex
+	socket    Socketer
+}
+
+/*
+close stops the delivery of events, unblocking any pending sends, and closes the
+channel.
+*/
+func (stream *EventStream) close() {
+	close(stream.done)
+	stream.mux.Lock()
+	stream.closed = true
+	stream.closeChan()
+	stream.mux.Unlock()
+}
+
+/*
+Send calls deliver unless the stream has been closed. deliver must return once
+done is closed.
+*/
+func (stream *EventStream) Send(deliver func(done <-chan struct{})) {
+	stream.mux.RLock()
+	defer stream.mux.RUnlock()
+	if stream.closed {
+		return
+	}
+	deliver(stream.done)
+}
+
+/*
+Subscribe binds the stream to a subscription. The stream is closed when the
+subscription is unsubscribed or when the socket is stopped.
+*/
+func (stream *EventStream) Subscribe(subscription *Subscription) *Subscription {
+	subscription.stream = stream
+	go func() {
+		select {
+		case <-stream.socket.Done():
+			subscription.Unsubscribe()
+		case <-stream.done:
+		}
+	}()
+	return subscription
+}
@@ -0,0 +1,87 @@
+package socket
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+func TestSubscriptionUnsubscribe(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSubscriptionUnsubscribe")
+	mockSocket := NewMock(socketURL)
+	defer mockSocket.Stop()
+
+	subscription := mockSocket.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {})
+	if "Page.loadEventFired" != subscription.Event() {
+		t.Errorf("Expected 'Page.loadEventFired', got '%s'", subscription.Event())
+	}
+	if handlers, _ := mockSocket.handlers.Get("Page.loadEventFired"); 1 != len(handlers) {
+		t.Errorf("Expected 1 handler, got %d", len(handlers))
+	}
+
+	if err := subscription.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+	if handlers, _ := mockSocket.handlers.Get("Page.loadEventFired"); 0 != len(handlers) {
+		t.Errorf("Expected 0 handlers, got %d", len(handlers))
+	}
+	if err := subscription.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+}
+
+func TestSubscribeChannel(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSubscribeChannel")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	events, subscription := mockSocket.Page().SubscribeLoadEventFired(1)
+	mockResult := &page.LoadEventFiredEvent{Timestamp: 1}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.loadEventFired",
+		Params: mockResultBytes,
+	})
+
+	select {
+	case event := <-events:
+		if mockResult.Timestamp != event.Timestamp {
+			t.Errorf("Expected %v, got %v", mockResult.Timestamp, event.Timestamp)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected an event, got nothing")
+	}
+
+	subscription.Unsubscribe()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Errorf("Expected a closed channel, got an event")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected a closed channel")
+	}
+}
+
+func TestSubscribeChannelSocketStop(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSubscribeChannelSocketStop")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+
+	events, _ := mockSocket.Page().SubscribeLoadEventFired(1)
+	mockSocket.Stop()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Errorf("Expected a closed channel, got an event")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected the channel to be closed when the socket stops")
+	}
+}
@@ -0,0 +1,114 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+Event is an event received by WaitForEvent.
+*/
+type Event struct {
+	// Err is set if the context was done or the socket was stopped before a
+	// matching event was received, or if the event carries a protocol error.
+	Err error
+
+	// Method is the name of the event.
+	Method string
+
+	// Params holds the raw event parameters.
+	Params json.RawMessage
+}
+
+/*
+WaitForEvent waits for the first event named name for which predicate returns
+true, see WaitFor. A nil predicate matches any event.
+
+WaitForEvent is a Socketer implementation.
+*/
+func (socket *Socket) WaitForEvent(
+	ctx context.Context,
+	name string,
+	predicate func(event *Event) bool,
+) <-chan *Event {
+	return WaitFor(ctx, socket, name, predicate)
+}
+
+/*
+WaitFor waits for the first event named name for which predicate returns true.
+name may be a pattern such as 'Network.*', see Socket.OnPattern. A nil
+predicate matches any event.
+
+The event handler is added before WaitFor returns, so an event caused by a
+command sent afterwards can't be missed, and is removed when a matching event
+is received, the context is done or the socket is stopped. The returned channel
+receives the matching event, or an event with a codes.SocketWaitCancelled
+error, and is then closed:
+
+	loaded := soc.WaitForEvent(ctx, "Page.loadEventFired", nil)
+	<-soc.Page().Navigate(&page.NavigateParams{URL: "https://example.com/"})
+	if event := <-loaded; nil != event.Err {
+		...
+	}
+*/
+func WaitFor(
+	ctx context.Context,
+	socket Socketer,
+	name string,
+	predicate func(event *Event) bool,
+) <-chan *Event {
+	eventChan := make(chan *Event, 1)
+	matched := make(chan struct{})
+	once := &sync.Once{}
+	deliver := func(event *Event) bool {
+		delivered := false
+		once.Do(func() {
+			eventChan <- event
+			close(eventChan)
+			delivered = true
+		})
+		return delivered
+	}
+
+	subscription := Subscribe(socket, NewEventHandler(
+		name,
+		func(response *Response) {
+			event := &Event{
+				Method: response.Method,
+				Params: response.Params,
+			}
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = NewProtocolError(response.Method, response.Error)
+			}
+			if nil != predicate && !predicate(event) {
+				return
+			}
+			if deliver(event) {
+				close(matched)
+			}
+		},
+	))
+
+	go func() {
+		defer subscription.Unsubscribe()
+		select {
+		case <-matched:
+		case <-ctx.Done():
+			deliver(&Event{
+				Err:    codes.Wrap(ctx.Err(), codes.SocketWaitCancelled, fmt.Sprintf("wait for event '%s' cancelled", name)),
+				Method: name,
+			})
+		case <-socket.Done():
+			deliver(&Event{
+				Err:    codes.New(codes.SocketWaitCancelled, fmt.Sprintf("wait for event '%s' cancelled: socket stopped", name)),
+				Method: name,
+			})
+		}
+	}()
+
+	return eventChan
+}
@@ -0,0 +1,123 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+func TestWaitForEvent(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestWaitForEvent")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+
+	eventChan := soc.WaitForEvent(context.Background(), "Page.newEvent", func(event *Event) bool {
+		value := struct{ Value int }{}
+		json.Unmarshal(event.Params, &value)
+		return 2 == value.Value
+	})
+
+	// Connect, then deliver the events.
+	soc.Call(context.Background(), "Page.enable", nil, nil)
+	conn.responses <- &Response{Method: "Page.newEvent", Params: []byte(`{"value":1}`)}
+	conn.responses <- &Response{Method: "Page.newEvent", Params: []byte(`{"value":2}`)}
+
+	select {
+	case event := <-eventChan:
+		if nil != event.Err {
+			t.Fatalf("Expected nil, got error: %v", event.Err)
+		}
+		if "Page.newEvent" != event.Method || `{"value":2}` != string(event.Params) {
+			t.Errorf(`Expected Page.newEvent '{"value":2}', got %s '%s'`, event.Method, event.Params)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected a Page.newEvent event")
+	}
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+
+	// The handler is removed once the event is received.
+	deadline := time.Now().Add(time.Second)
+	for 0 != len(soc.handlers.Match("Page.newEvent")) {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the event handler to be removed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWaitForEventCancelled(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestWaitForEventCancelled")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	event := <-soc.WaitForEvent(ctx, "Page.newEvent", nil)
+	if !codes.Is(event.Err, codes.SocketWaitCancelled) || !errors.Is(event.Err, context.DeadlineExceeded) {
+		t.Errorf("Expected a SocketWaitCancelled error, got %v", event.Err)
+	}
+
+	eventChan := soc.WaitForEvent(context.Background(), "Page.newEvent", nil)
+	soc.Stop()
+	select {
+	case event := <-eventChan:
+		if !codes.Is(event.Err, codes.SocketWaitCancelled) {
+			t.Errorf("Expected a SocketWaitCancelled error, got %v", event.Err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected the wait to end when the socket is stopped")
+	}
+}
+
+func TestPageProtocolWaitLoadEventFired(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageProtocolWaitLoadEventFired")
+	conn := newMiddlewareWebSocket()
+	soc := New(socketURL, WithWebSocket(func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	defer soc.Stop()
+
+	eventChan := soc.Page().WaitLoadEventFired(
+		context.Background(),
+		func(event *page.LoadEventFiredEvent) bool {
+			return 2 == event.Timestamp
+		},
+	)
+
+	// Connect, then deliver the events.
+	soc.Call(context.Background(), "Page.enable", nil, nil)
+	conn.responses <- &Response{Method: "Page.loadEventFired", Params: []byte(`{"timestamp":1}`)}
+	conn.responses <- &Response{Method: "Page.loadEventFired", Params: []byte(`{"timestamp":2}`)}
+
+	select {
+	case event := <-eventChan:
+		if nil != event.Err {
+			t.Fatalf("Expected nil, got error: %v", event.Err)
+		}
+		if 2 != event.Timestamp {
+			t.Errorf("Expected 2, got %v", event.Timestamp)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected a Page.loadEventFired event")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if event := <-soc.Page().WaitLoadEventFired(ctx); !codes.Is(event.Err, codes.SocketWaitCancelled) {
+		t.Errorf("Expected a SocketWaitCancelled error, got %v", event.Err)
+	}
+}
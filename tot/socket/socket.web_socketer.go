@@ -1,13 +1,13 @@
 package socket
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
 
-	errs "github.com/bdlm/errors"
-	"github.com/bdlm/log"
 	"github.com/gorilla/websocket"
 	"github.com/mkenney/go-chrome/codes"
 )
@@ -26,16 +26,13 @@ func NewWebsocket(socketURL *url.URL) (WebSocketer, error) {
 	}
 	header := http.Header{"Origin": []string{}}
 
-	websocket, response, err := dialer.Dial(socketURL.String(), header)
+	websocket, _, err := dialer.Dial(socketURL.String(), header)
 	if err != nil {
-		return nil, errs.Wrap(err, codes.WebsocketConnectFailed, fmt.Sprintf(
+		return nil, codes.Wrap(err, codes.WebsocketConnectFailed, fmt.Sprintf(
 			"%s websocket connection failed",
 			socketURL.String(),
 		))
 	}
-	log.WithFields(log.Fields{"status": response.Status, "url": socketURL.String()}).
-		Info("Websocket connection established")
-
 	return &ChromeWebSocket{conn: websocket}, nil
 }
 
@@ -48,6 +45,10 @@ ChromeWebSocket represents a WebSocketer interface
 type ChromeWebSocket struct {
 	conn          *websocket.Conn
 	mockResponses []*Response
+
+	// writeMux serializes writes, websocket connections support one
+	// concurrent writer.
+	writeMux sync.Mutex
 }
 
 /*
@@ -75,11 +76,28 @@ Response{} pointer with the AddMockData() method.
 */
 func (socket *ChromeWebSocket) ReadJSON(v interface{}) error {
 	if nil == socket.conn {
-		return errs.New(codes.WebsocketNotConnected, "not connected")
+		return codes.New(codes.WebsocketNotConnected, "not connected")
 	}
 	return socket.conn.ReadJSON(&v)
 }
 
+/*
+ReadMessage reads the next message from the websocket connection into buf.
+
+ReadMessage is a MessageReader implementation.
+*/
+func (socket *ChromeWebSocket) ReadMessage(buf *bytes.Buffer) error {
+	if nil == socket.conn {
+		return codes.New(codes.WebsocketNotConnected, "not connected")
+	}
+	_, reader, err := socket.conn.NextReader()
+	if nil != err {
+		return err
+	}
+	_, err = buf.ReadFrom(reader)
+	return err
+}
+
 /*
 WriteJSON marshalls the provided data as JSON and writes it to the websocket.
 
@@ -87,11 +105,13 @@ WriteJSON is a WebSocketer implementation.
 */
 func (socket *ChromeWebSocket) WriteJSON(v interface{}) error {
 	if nil == socket.conn {
-		return errs.New(codes.WebsocketNotConnected, "not connected")
+		return codes.New(codes.WebsocketNotConnected, "not connected")
 	}
 	tmp, _ := json.Marshal(v)
 	if len(tmp) > 1*1024*1024 {
 		return fmt.Errorf("payload too large. chrome supports a maximum payload size of 1MB. See https://github.com/gorilla/websocket/issues/245")
 	}
+	socket.writeMux.Lock()
+	defer socket.writeMux.Unlock()
 	return socket.conn.WriteJSON(v)
 }
@@ -0,0 +1,16 @@
+package storage
+
+/*
+TypeEnum is the former name of StorageTypeEnum.
+
+Deprecated: use StorageTypeEnum.
+*/
+type TypeEnum = StorageTypeEnum
+
+/*
+Type is the former name of StorageType. The
+Type.Appcache value was removed from the protocol.
+
+Deprecated: use StorageType.
+*/
+var Type = StorageType
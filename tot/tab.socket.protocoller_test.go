@@ -26,11 +26,19 @@ func TestProtocoller1(t *testing.T) {
 		t.Errorf("Expected struct, received nil")
 	}
 
-	if testVal := tab.ApplicationCache(); nil == testVal {
+	if testVal := tab.Audits(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
 
-	if testVal := tab.Audits(); nil == testVal {
+	if testVal := tab.Autofill(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
+	if testVal := tab.BackgroundService(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
+	if testVal := tab.BluetoothEmulation(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
 
@@ -42,6 +50,10 @@ func TestProtocoller1(t *testing.T) {
 		t.Errorf("Expected struct, received nil")
 	}
 
+	if testVal := tab.Cast(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
 	if testVal := tab.Console(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
@@ -50,11 +62,11 @@ func TestProtocoller1(t *testing.T) {
 		t.Errorf("Expected struct, received nil")
 	}
 
-	if testVal := tab.Database(); nil == testVal {
+	if testVal := tab.Debugger(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
 
-	if testVal := tab.Debugger(); nil == testVal {
+	if testVal := tab.DeviceAccess(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
 
@@ -62,6 +74,10 @@ func TestProtocoller1(t *testing.T) {
 		t.Errorf("Expected struct, received nil")
 	}
 
+	if testVal := tab.DOM(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
 	if testVal := tab.DOMDebugger(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
@@ -69,26 +85,32 @@ func TestProtocoller1(t *testing.T) {
 	if testVal := tab.DOMSnapshot(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
-}
 
-func TestProtocoller2(t *testing.T) {
-	browser := NewMock(
-		&Flags{},
-		"", //"path/to/chrome",
-		"", //"path/to/stderr",
-		"", //"path/to/stdout",
-		"", //"path/to/workdir",
-	)
-	tab, _ := browser.NewTab("https://TestProtocoller")
 	if testVal := tab.DOMStorage(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
 
-	if testVal := tab.DOM(); nil == testVal {
+	if testVal := tab.Emulation(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
 
-	if testVal := tab.Emulation(); nil == testVal {
+	if testVal := tab.EventBreakpoints(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
+	if testVal := tab.Extensions(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
+	if testVal := tab.FedCm(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
+	if testVal := tab.Fetch(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
+	if testVal := tab.FileSystem(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
 
@@ -108,6 +130,10 @@ func TestProtocoller2(t *testing.T) {
 		t.Errorf("Expected struct, received nil")
 	}
 
+	if testVal := tab.Inspector(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
 	if testVal := tab.IO(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
@@ -120,6 +146,10 @@ func TestProtocoller2(t *testing.T) {
 		t.Errorf("Expected struct, received nil")
 	}
 
+	if testVal := tab.Media(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
 	if testVal := tab.Memory(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
@@ -135,25 +165,27 @@ func TestProtocoller2(t *testing.T) {
 	if testVal := tab.Page(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
-}
 
-func TestProtocoller3(t *testing.T) {
-	browser := NewMock(
-		&Flags{},
-		"", //"path/to/chrome",
-		"", //"path/to/stderr",
-		"", //"path/to/stdout",
-		"", //"path/to/workdir",
-	)
-	tab, _ := browser.NewTab("https://TestProtocoller")
 	if testVal := tab.Performance(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
 
+	if testVal := tab.PerformanceTimeline(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
+	if testVal := tab.Preload(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
 	if testVal := tab.Profiler(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
 
+	if testVal := tab.PWA(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
 	if testVal := tab.Runtime(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
@@ -189,4 +221,12 @@ func TestProtocoller3(t *testing.T) {
 	if testVal := tab.Tracing(); nil == testVal {
 		t.Errorf("Expected struct, received nil")
 	}
+
+	if testVal := tab.WebAudio(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
+
+	if testVal := tab.WebAuthn(); nil == testVal {
+		t.Errorf("Expected struct, received nil")
+	}
 }
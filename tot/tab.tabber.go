@@ -3,10 +3,10 @@ package chrome
 import (
 	"fmt"
 	"net/url"
+	"sync"
 
-	errs "github.com/bdlm/errors"
-	"github.com/bdlm/log"
 	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/logger"
 	"github.com/mkenney/go-chrome/tot/socket"
 )
 
@@ -21,7 +21,7 @@ func (chrome *Chrome) NewTab(uri string) (*Tab, error) {
 	}
 	targetURL, err := url.Parse(uri)
 	if nil != err {
-		return nil, errs.Wrap(err, codes.TabURLInvalid, "invalid URL")
+		return nil, codes.Wrap(err, codes.TabURLInvalid, "invalid URL")
 	}
 
 	tab := &Tab{
@@ -30,26 +30,50 @@ func (chrome *Chrome) NewTab(uri string) (*Tab, error) {
 		url:    targetURL,
 	}
 
+	if nil != chrome.pipe {
+		if err = chrome.newPipeTab(tab); nil != err {
+			return nil, err
+		}
+		return chrome.addTab(tab), nil
+	}
+
 	_, err = tab.Chromium().Query(
 		fmt.Sprintf("/json/new?%s", url.QueryEscape(uri)),
 		url.Values{},
 		tab.data,
 	)
 	if nil != err {
-		return nil, errs.Wrap(err, codes.TabQueryFailed, fmt.Sprintf("/new?%s query failed", url.QueryEscape(uri)))
+		return nil, codes.Wrap(err, codes.TabQueryFailed, fmt.Sprintf("/new?%s query failed", url.QueryEscape(uri)))
 	}
 
 	websocketURL, err := url.Parse(tab.Data().WebSocketDebuggerURL)
 	if nil != err {
-		return nil, errs.Wrap(err, codes.TabWebsocketURLInvalid, fmt.Sprintf("invalid websocket URL '%s'", tab.Data().WebSocketDebuggerURL))
+		return nil, codes.Wrap(err, codes.TabWebsocketURLInvalid, fmt.Sprintf("invalid websocket URL '%s'", tab.Data().WebSocketDebuggerURL))
 	}
 
-	socket := socket.New(websocketURL)
+	socket := socket.New(websocketURL, chrome.newSocketOptions()...)
 	tab.socket = socket
 	tab.protocol = socket
-	chrome.tabs = append(chrome.tabs, tab)
 
-	return tab, nil
+	return chrome.addTab(tab), nil
+}
+
+/*
+addTab adds a new tab to the list of open tabs. Target discovery may already
+have adopted the target of the tab, in that case the new tab's socket is stopped
+and the existing tab is returned.
+*/
+func (chrome *Chrome) addTab(tab *Tab) *Tab {
+	chrome.tabsMux.Lock()
+	defer chrome.tabsMux.Unlock()
+	for _, existing := range chrome.tabs {
+		if existing.Data().ID == tab.Data().ID {
+			go tab.Socket().Stop()
+			return existing
+		}
+	}
+	chrome.tabs = append(chrome.tabs, tab)
+	return tab
 }
 
 /*
@@ -58,6 +82,7 @@ Tab is a struct representing an individual Chrome tab
 type Tab struct {
 	chrome   Chromium
 	data     *TabData
+	dataMux  sync.Mutex
 	protocol socket.Protocoller
 	socket   socket.Socketer
 	url      *url.URL
@@ -76,23 +101,32 @@ Close implements Tabber.
 func (tab *Tab) Close() (interface{}, error) {
 	var err error
 	var result interface{}
+	chrome, ok := tab.Chromium().(*Chrome)
+	if ok && nil != chrome.pipe {
+		return chrome.closePipeTab(tab)
+	}
 	tab.Socket().Stop()
 	_, err = tab.Chromium().Query(fmt.Sprintf("/json/close/%s", tab.Data().ID), url.Values{}, &result)
 	if nil != err {
-		log.WithFields(log.Fields{
-			"result": result,
-			"error":  err,
-		}).Warn(err)
-		return nil, errs.Wrap(err, 0, fmt.Sprintf("close/%s query failed", tab.Data().ID))
+		if ok {
+			chrome.Logger().Warn("could not close tab", logger.Fields{
+				"result": result,
+				"error":  err,
+			})
+		}
+		return nil, codes.Wrap(err, 0, fmt.Sprintf("close/%s query failed", tab.Data().ID))
 	}
 	tab.Chromium().RemoveTab(tab)
 	return result, nil
 }
 
 /*
-Data implements Tabber.
+Data implements Tabber. The metadata is replaced rather than modified when the
+target changes, so the result is a consistent snapshot.
 */
 func (tab *Tab) Data() *TabData {
+	tab.dataMux.Lock()
+	defer tab.dataMux.Unlock()
 	return tab.data
 }
 
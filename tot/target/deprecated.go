@@ -0,0 +1,36 @@
+package target
+
+/*
+CreatedEvent is the former name of TargetCreatedEvent.
+
+Deprecated: use TargetCreatedEvent.
+*/
+type CreatedEvent = TargetCreatedEvent
+
+/*
+DestroyedEvent is the former name of TargetDestroyedEvent.
+
+Deprecated: use TargetDestroyedEvent.
+*/
+type DestroyedEvent = TargetDestroyedEvent
+
+/*
+ID is the former name of TargetID.
+
+Deprecated: use TargetID.
+*/
+type ID = TargetID
+
+/*
+Info is the former name of TargetInfo.
+
+Deprecated: use TargetInfo.
+*/
+type Info = TargetInfo
+
+/*
+InfoChangedEvent is the former name of TargetInfoChangedEvent.
+
+Deprecated: use TargetInfoChangedEvent.
+*/
+type InfoChangedEvent = TargetInfoChangedEvent
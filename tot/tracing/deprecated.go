@@ -0,0 +1,8 @@
+package tracing
+
+/*
+CompleteEvent is the former name of TracingCompleteEvent.
+
+Deprecated: use TracingCompleteEvent.
+*/
+type CompleteEvent = TracingCompleteEvent
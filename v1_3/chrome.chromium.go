@@ -0,0 +1,77 @@
+package chrome
+
+import (
+	"fmt"
+
+	"github.com/mkenney/go-chrome/codes"
+	tot "github.com/mkenney/go-chrome/tot"
+)
+
+/*
+New returns a pointer to a Chromium instance.
+*/
+func New(
+	flags ChromiumFlags,
+	binary string,
+	workdir string,
+	stdout string,
+	stderr string,
+) *Chrome {
+	return &Chrome{
+		Chrome: tot.New(flags, binary, workdir, stdout, stderr),
+	}
+}
+
+/*
+Chrome implements Chromium.
+
+The Chromium process is managed by the tip-of-tree implementation, Chrome only
+tracks the tabs using the stable protocol API.
+*/
+type Chrome struct {
+	*tot.Chrome
+
+	// tabs is a list of the currently open tabs.
+	tabs []*Tab
+}
+
+/*
+Close implements Chromium.
+*/
+func (chrome *Chrome) Close() error {
+	err := chrome.Chrome.Close()
+	chrome.tabs = []*Tab{}
+	return err
+}
+
+/*
+GetTab implements Chromium.
+*/
+func (chrome *Chrome) GetTab(tabID string) (Tabber, error) {
+	for _, tab := range chrome.Tabs() {
+		if tab.Data().ID == tabID {
+			return tab, nil
+		}
+	}
+	return nil, codes.New(codes.ChromeTabNotFound, fmt.Sprintf("tab '%s' not found", tabID))
+}
+
+/*
+RemoveTab implements Chromium.
+*/
+func (chrome *Chrome) RemoveTab(tab *Tab) {
+	for k, t := range chrome.tabs {
+		if t == tab {
+			chrome.tabs = append(chrome.tabs[:k], chrome.tabs[k+1:]...)
+			break
+		}
+	}
+	chrome.Chrome.RemoveTab(tab.tab)
+}
+
+/*
+Tabs implements Chromium.
+*/
+func (chrome *Chrome) Tabs() []*Tab {
+	return chrome.tabs
+}
@@ -0,0 +1,109 @@
+package chrome
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mkenney/go-chrome/v1_3/socket"
+)
+
+/*
+newTestChrome returns a Chrome instance querying a mock developer tools HTTP
+endpoint.
+*/
+func newTestChrome() (*Chrome, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/json/new"):
+			json.NewEncoder(w).Encode(&TabData{
+				ID:                   "tab-1",
+				Type:                 "page",
+				URL:                  r.URL.RawQuery,
+				WebSocketDebuggerURL: "ws://" + r.Host + "/devtools/page/tab-1",
+			})
+		case strings.HasPrefix(r.URL.Path, "/json/close/"):
+			w.Write([]byte("Target is closing"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	serverURL, _ := url.Parse(server.URL)
+	port, _ := strconv.Atoi(serverURL.Port())
+	flags := &Flags{}
+	flags.Set("addr", serverURL.Hostname())
+	flags.Set("port", port)
+	return New(flags, "", "", "", ""), server
+}
+
+func TestChromiumTabs(t *testing.T) {
+	chrome, server := newTestChrome()
+	defer server.Close()
+
+	tab, err := chrome.NewTab("https://TestChromiumTabs")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "tab-1" != tab.Data().ID {
+		t.Errorf("Expected 'tab-1', received '%s'", tab.Data().ID)
+	}
+	if "https://TestChromiumTabs" != tab.URL().String() {
+		t.Errorf("Expected 'https://TestChromiumTabs', received '%s'", tab.URL().String())
+	}
+	if tab.Chromium() != Chromium(chrome) {
+		t.Errorf("Expected the tab to reference its Chromium instance")
+	}
+	if 1 != len(chrome.Tabs()) {
+		t.Errorf("Expected 1 tab, received %d", len(chrome.Tabs()))
+	}
+
+	found, err := chrome.GetTab("tab-1")
+	if nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if found != Tabber(tab) {
+		t.Errorf("Expected GetTab to return the open tab")
+	}
+	if _, err := chrome.GetTab("tab-2"); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+
+	if _, err := tab.Close(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if 0 != len(chrome.Tabs()) {
+		t.Errorf("Expected 0 tabs, received %d", len(chrome.Tabs()))
+	}
+}
+
+func TestTabProtocoller(t *testing.T) {
+	chrome, server := newTestChrome()
+	defer server.Close()
+
+	tab, err := chrome.NewTab("https://TestTabProtocoller")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer tab.Close()
+
+	protocoller := reflect.TypeOf((*socket.Protocoller)(nil)).Elem()
+	tabValue := reflect.ValueOf(tab)
+	protocolValue := reflect.ValueOf(tab.Protocol())
+	for a := 0; a < protocoller.NumMethod(); a++ {
+		name := protocoller.Method(a).Name
+		fromTab := tabValue.MethodByName(name).Call(nil)[0]
+		fromProtocol := protocolValue.MethodByName(name).Call(nil)[0]
+		if fromTab.IsNil() {
+			t.Errorf("%s: expected struct, received nil", name)
+		}
+		if fromTab.Pointer() != fromProtocol.Pointer() {
+			t.Errorf("%s: expected the tab to use its socket protocol", name)
+		}
+	}
+}
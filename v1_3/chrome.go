@@ -0,0 +1,51 @@
+/*
+Package chrome aims to be a complete Chrome DevTools Protocol Viewer
+implementation.
+
+This version implements the stable 1.3 API. See
+https://chromedevtools.github.io/devtools-protocol/1-3/ for details.
+
+The Chromium process management and the websocket transport are shared with the
+tip-of-tree package, only the protocol API is limited to the stable surface.
+*/
+package chrome
+
+import (
+	"context"
+
+	tot "github.com/mkenney/go-chrome/tot"
+)
+
+/*
+Binary describes a Chromium binary found by FindBinary.
+*/
+type Binary = tot.Binary
+
+/*
+ChromiumFlags provides an interface for managing CLI arguments to the Chromium
+binary.
+*/
+type ChromiumFlags = tot.ChromiumFlags
+
+/*
+Flags is a ChromiumFlags implementation.
+*/
+type Flags = tot.Flags
+
+/*
+TabData holds metadata about a browser tab.
+*/
+type TabData = tot.TabData
+
+/*
+Version is a struct representing the Chromium version information.
+*/
+type Version = tot.Version
+
+/*
+FindBinary looks for a usable Chromium binary, honouring the CHROME_PATH
+environment variable. See the tip-of-tree FindBinary for details.
+*/
+func FindBinary(ctx context.Context) (*Binary, error) {
+	return tot.FindBinary(ctx)
+}
@@ -0,0 +1,9 @@
+package chrome
+
+/*
+The domain packages, their socket protocol wrappers, the Protocoller interface
+and the Tab accessors are generated from the stable surface of the protocol
+definitions in the protocol directory. See cmd/cdtpgen for details.
+*/
+
+//go:generate go run ../cmd/cdtpgen -browser protocol/browser_protocol.json -js protocol/js_protocol.json -out . -import github.com/mkenney/go-chrome/v1_3 -version 1-3 -stable -protocoller
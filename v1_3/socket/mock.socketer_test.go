@@ -0,0 +1,41 @@
+package socket
+
+import (
+	"net/url"
+)
+
+/*
+NewMock returns a Chromium Socketer mock for unit testing.
+*/
+func NewMock(socketURL *url.URL, options ...Option) *MockSocket {
+	conn, _ := NewMockWebsocket(socketURL)
+	options = append(options, WithWebSocket(func(socketURL *url.URL) (WebSocketer, error) {
+		return conn, nil
+	}))
+	return &MockSocket{
+		Socket: New(socketURL, options...),
+		conn:   conn,
+	}
+}
+
+/*
+MockSocket is a Socket using a mock websocket connection.
+*/
+type MockSocket struct {
+	*Socket
+	conn WebSocketer
+}
+
+/*
+Conn returns the mock websocket connection.
+*/
+func (socket *MockSocket) Conn() WebSocketer {
+	return socket.conn
+}
+
+/*
+Listen is a no-op, New already starts the socket read loop.
+*/
+func (socket *MockSocket) Listen() error {
+	return nil
+}
@@ -0,0 +1,287 @@
+/*
+Package socket provides the websocket API for the stable 1.3 release of the
+Chrome DevTools Protocol.
+
+The websocket transport, command and event handling are shared with the
+tip-of-tree implementation, this package only limits the protocol API to the
+stable surface. See https://chromedevtools.github.io/devtools-protocol/1-3/ for
+details.
+*/
+package socket
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/mkenney/go-chrome/codes"
+	transport "github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+Command is the socket command implementation.
+*/
+type Command = transport.Command
+
+/*
+Commander defines the interface for websocket commands.
+*/
+type Commander = transport.Commander
+
+/*
+DomainHandle is a reference-counted handle to an enabled protocol domain.
+*/
+type DomainHandle = transport.DomainHandle
+
+/*
+EnabledDomain describes a protocol domain enabled on a socket or session.
+*/
+type EnabledDomain = transport.EnabledDomain
+
+/*
+Error represents a socket response error.
+*/
+type Error = transport.Error
+
+/*
+Event is an event received by WaitForEvent.
+*/
+type Event = transport.Event
+
+/*
+EventHandler defines the interface for websocket event handlers.
+*/
+type EventHandler = transport.EventHandler
+
+/*
+EventQueue is a serial queue that delivers events in the order they were pushed.
+*/
+type EventQueue = transport.EventQueue
+
+/*
+EventStream guards the delivery of events to a typed subscription channel.
+*/
+type EventStream = transport.EventStream
+
+/*
+Option configures a socket.
+*/
+type Option = transport.Option
+
+/*
+OverflowPolicy defines how an EventQueue handles events when its buffer is full.
+*/
+type OverflowPolicy = transport.OverflowPolicy
+
+/*
+PendingCommand describes a command that is waiting for a response.
+*/
+type PendingCommand = transport.PendingCommand
+
+/*
+ProtocolError is the error returned by a protocol command that failed in the
+browser.
+*/
+type ProtocolError = transport.ProtocolError
+
+/*
+ReconnectPolicy configures socket reconnection.
+*/
+type ReconnectPolicy = transport.ReconnectPolicy
+
+/*
+Response represents a socket message.
+*/
+type Response = transport.Response
+
+/*
+Subscription is a handle for an event handler added to a socket.
+*/
+type Subscription = transport.Subscription
+
+/*
+Socketer defines the websocket connection interface for managing sockets.
+*/
+type Socketer = transport.Socketer
+
+/*
+WebSocketer defines the minimum interface required API for web socket
+connections to the Chromium browser.
+*/
+type WebSocketer = transport.WebSocketer
+
+const (
+	// OverflowBlock blocks the socket read loop until the queue has room for
+	// the event.
+	OverflowBlock = transport.OverflowBlock
+
+	// OverflowDropOldest discards the oldest queued event to make room for the
+	// new event.
+	OverflowDropOldest = transport.OverflowDropOldest
+
+	// OverflowDropNewest discards the new event.
+	OverflowDropNewest = transport.OverflowDropNewest
+)
+
+/*
+JSON-RPC error codes returned by Chromium.
+*/
+const (
+	// CodeServerError is returned for failed commands.
+	CodeServerError = transport.CodeServerError
+
+	// CodeMethodNotFound is returned for unknown or unsupported methods.
+	CodeMethodNotFound = transport.CodeMethodNotFound
+
+	// CodeInvalidParams is returned for invalid command parameters.
+	CodeInvalidParams = transport.CodeInvalidParams
+)
+
+/*
+Sentinel errors matching protocol errors with the corresponding code.
+*/
+var (
+	ErrServerError    = transport.ErrServerError
+	ErrMethodNotFound = transport.ErrMethodNotFound
+	ErrInvalidParams  = transport.ErrInvalidParams
+)
+
+/*
+NewCommand returns a new socket command.
+*/
+func NewCommand(socket Socketer, method string, params interface{}) *Command {
+	return transport.NewCommand(socket, method, params)
+}
+
+/*
+NewProtocolError returns the ProtocolError of a response error.
+*/
+func NewProtocolError(method string, err *Error) *ProtocolError {
+	return transport.NewProtocolError(method, err)
+}
+
+/*
+NewEventHandler returns an event handler for the named event.
+*/
+func NewEventHandler(
+	name string,
+	callback func(response *Response),
+) EventHandler {
+	return transport.NewEventHandler(name, callback)
+}
+
+/*
+NewEventQueue returns a running EventQueue that buffers up to size events and
+handles overflow according to policy.
+*/
+func NewEventQueue(size int, policy OverflowPolicy) *EventQueue {
+	return transport.NewEventQueue(size, policy)
+}
+
+/*
+NewEventStream returns an EventStream for a typed subscription channel.
+*/
+func NewEventStream(socket Socketer, closeChan func()) *EventStream {
+	return transport.NewEventStream(socket, closeChan)
+}
+
+/*
+Subscribe adds an event handler to the socket and returns a Subscription that
+can be used to remove it again.
+*/
+func Subscribe(socket Socketer, handler EventHandler) *Subscription {
+	return transport.Subscribe(socket, handler)
+}
+
+/*
+WaitFor waits for the first event named name for which predicate returns true.
+*/
+func WaitFor(ctx context.Context, socket Socketer, name string, predicate func(event *Event) bool) <-chan *Event {
+	return transport.WaitFor(ctx, socket, name, predicate)
+}
+
+/*
+WithCommandTimeout sets the default command timeout of a socket.
+*/
+func WithCommandTimeout(timeout time.Duration) Option {
+	return transport.WithCommandTimeout(timeout)
+}
+
+/*
+WithOrderedEvents delivers events to the event handlers through a serial queue
+in wire order.
+*/
+func WithOrderedEvents(size int, policy OverflowPolicy) Option {
+	return transport.WithOrderedEvents(size, policy)
+}
+
+/*
+WithReconnect enables socket reconnection using the specified policy.
+*/
+func WithReconnect(policy *ReconnectPolicy) Option {
+	return transport.WithReconnect(policy)
+}
+
+/*
+WithWebSocket sets the function used to open the websocket connection.
+*/
+func WithWebSocket(newSocket func(socketURL *url.URL) (WebSocketer, error)) Option {
+	return transport.WithWebSocket(newSocket)
+}
+
+/*
+New returns a new stable protocol socket connected to the specified URL. The
+socket starts listening immediately.
+*/
+func New(url *url.URL, options ...Option) *Socket {
+	return Wrap(transport.New(url, options...))
+}
+
+/*
+Wrap returns a stable protocol socket that sends its commands through an
+existing Socketer.
+*/
+func Wrap(socket Socketer) *Socket {
+	return &Socket{
+		Socketer:  socket,
+		protocols: newProtocols(socket),
+	}
+}
+
+/*
+Socket is a Socketer and Protocoller implementation limited to the stable
+protocol API.
+*/
+type Socket struct {
+	Socketer
+	protocols
+}
+
+/*
+AttachToTarget attaches to the specified target in flattened session mode and
+returns a stable protocol socket bound to the session. The socket must wrap a
+browser connection created by New or a tip-of-tree socket.
+*/
+func (socket *Socket) AttachToTarget(ctx context.Context, targetID string) (*Socket, error) {
+	browser, ok := socket.Socketer.(*transport.Socket)
+	if !ok {
+		return nil, codes.New(codes.SocketSessionAttachFailed, "socket does not support target sessions")
+	}
+	session, err := browser.AttachToTarget(ctx, targetID)
+	if nil != err {
+		return nil, err
+	}
+	return Wrap(session), nil
+}
+
+/*
+Session returns a stable protocol socket bound to an attached session ID. It
+returns nil if the socket does not support target sessions.
+*/
+func (socket *Socket) Session(sessionID string) *Socket {
+	browser, ok := socket.Socketer.(*transport.Socket)
+	if !ok {
+		return nil
+	}
+	return Wrap(browser.Session(sessionID))
+}
@@ -0,0 +1,30 @@
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	transport "github.com/mkenney/go-chrome/tot/socket"
+)
+
+func TestSession(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSession")
+	mockSocket := NewMock(socketURL)
+	defer mockSocket.Stop()
+
+	session := mockSocket.Session("session-1")
+	if nil == session {
+		t.Fatalf("Expected a session, got nil")
+	}
+	if id := session.Socketer.(*transport.Session).ID(); "session-1" != id {
+		t.Errorf("Expected 'session-1', got '%s'", id)
+	}
+	if nil == session.Page() {
+		t.Errorf("Expected struct, received nil")
+	}
+
+	wrapped := Wrap(session)
+	if nil != wrapped.Session("session-2") {
+		t.Errorf("Expected nil for a socket without session support")
+	}
+}
@@ -0,0 +1,83 @@
+package chrome
+
+import (
+	"net/url"
+
+	tot "github.com/mkenney/go-chrome/tot"
+	"github.com/mkenney/go-chrome/v1_3/socket"
+)
+
+/*
+NewTab spawns a new Tab and returns a reference to it
+*/
+func (chrome *Chrome) NewTab(uri string) (*Tab, error) {
+	tab, err := chrome.Chrome.NewTab(uri)
+	if nil != err {
+		return nil, err
+	}
+
+	stable := &Tab{
+		chrome:   chrome,
+		tab:      tab,
+		protocol: socket.Wrap(tab.Socket()),
+	}
+	chrome.tabs = append(chrome.tabs, stable)
+
+	return stable, nil
+}
+
+/*
+Tab is a struct representing an individual Chrome tab
+*/
+type Tab struct {
+	chrome   *Chrome
+	tab      *tot.Tab
+	protocol *socket.Socket
+}
+
+/*
+Chromium implements Tabber.
+*/
+func (tab *Tab) Chromium() Chromium {
+	return tab.chrome
+}
+
+/*
+Close implements Tabber.
+*/
+func (tab *Tab) Close() (interface{}, error) {
+	result, err := tab.tab.Close()
+	if nil != err {
+		return nil, err
+	}
+	tab.chrome.RemoveTab(tab)
+	return result, nil
+}
+
+/*
+Data implements Tabber.
+*/
+func (tab *Tab) Data() *TabData {
+	return tab.tab.Data()
+}
+
+/*
+Protocol implements Tabber.
+*/
+func (tab *Tab) Protocol() socket.Protocoller {
+	return tab.protocol
+}
+
+/*
+Socket implements Tabber.
+*/
+func (tab *Tab) Socket() socket.Socketer {
+	return tab.protocol
+}
+
+/*
+URL implements Tabber.
+*/
+func (tab *Tab) URL() *url.URL {
+	return tab.tab.URL()
+}